@@ -17,9 +17,11 @@ import (
 	"github.com/ClusterCockpit/cc-backend/internal/auth"
 	"github.com/ClusterCockpit/cc-backend/internal/config"
 	"github.com/ClusterCockpit/cc-backend/internal/importer"
+	"github.com/ClusterCockpit/cc-backend/internal/metricDataDispatcher"
 	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
 	"github.com/ClusterCockpit/cc-backend/internal/repository"
 	"github.com/ClusterCockpit/cc-backend/internal/taskManager"
+	"github.com/ClusterCockpit/cc-backend/internal/webhook"
 	"github.com/ClusterCockpit/cc-backend/pkg/archive"
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
 	"github.com/ClusterCockpit/cc-backend/pkg/runtimeEnv"
@@ -27,6 +29,7 @@ import (
 	"github.com/google/gops/agent"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -89,7 +92,7 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
-		os.Exit(0)
+		flagReindexSearch = true
 	}
 
 	if flagRevertDB {
@@ -110,6 +113,15 @@ func main() {
 
 	repository.Connect(config.Keys.DBDriver, config.Keys.DB)
 
+	if flagReindexSearch {
+		cnt, err := repository.GetJobRepository().RebuildSearchIndex()
+		if err != nil {
+			log.Fatalf("rebuilding the job search index failed: %s", err.Error())
+		}
+		log.Printf("Rebuilt the job search index of %d jobs", cnt)
+		os.Exit(0)
+	}
+
 	if flagInit {
 		initEnv()
 		fmt.Print("Successfully setup environment!\n")
@@ -186,6 +198,10 @@ func main() {
 		log.Fatalf("failed to initialize metricdata repository: %s", err.Error())
 	}
 
+	if err := metricDataDispatcher.InitDiskCache(config.Keys.MetricDataCache); err != nil {
+		log.Fatalf("failed to initialize metric data cache: %s", err.Error())
+	}
+
 	if flagReinitDB {
 		if err := importer.InitDB(); err != nil {
 			log.Fatalf("failed to re-initialize repository DB: %s", err.Error())
@@ -203,6 +219,9 @@ func main() {
 	}
 
 	archiver.Start(repository.GetJobRepository())
+	if err := webhook.Start(); err != nil {
+		log.Fatalf("failed to start webhook delivery: %s", err.Error())
+	}
 	taskManager.Start()
 	serverInit()
 
@@ -224,6 +243,7 @@ func main() {
 
 		serverShutdown()
 
+		webhook.Shutdown()
 		taskManager.Shutdown()
 	}()
 
@@ -71,6 +71,9 @@ func serverInit() {
 		})
 	}
 
+	// Record all mutations in the audit log
+	graphQLEndpoint.AroundOperations(api.AuditMutations)
+
 	authHandle := auth.GetAuthInstance()
 
 	apiHandle = api.New()
@@ -101,6 +104,10 @@ func serverInit() {
 		web.RenderTemplate(rw, "privacy.tmpl", &web.Page{Title: "Privacy", Build: buildInfo})
 	})
 
+	if config.Keys.MetricsEndpoint != nil {
+		router.Handle("/metrics", api.MetricsHandler(config.Keys.MetricsEndpoint)).Methods(http.MethodGet)
+	}
+
 	secured := router.PathPrefix("/").Subrouter()
 	securedapi := router.PathPrefix("/api").Subrouter()
 	userapi := router.PathPrefix("/userapi").Subrouter()
@@ -208,7 +215,7 @@ func serverInit() {
 		router.PathPrefix("/swagger/").Handler(httpSwagger.Handler(
 			httpSwagger.URL("http://" + config.Keys.Addr + "/swagger/doc.json"))).Methods(http.MethodGet)
 	}
-	secured.Handle("/query", graphQLEndpoint)
+	secured.Handle("/query", api.AuditContext(graphQLEndpoint))
 
 	// Send a searchId and then reply with a redirect to a user, or directly send query to job table for jobid and project.
 	secured.HandleFunc("/search", func(rw http.ResponseWriter, r *http.Request) {
@@ -6,14 +6,21 @@ package api_test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -23,13 +30,16 @@ import (
 	"github.com/ClusterCockpit/cc-backend/internal/auth"
 	"github.com/ClusterCockpit/cc-backend/internal/config"
 	"github.com/ClusterCockpit/cc-backend/internal/graph"
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
 	"github.com/ClusterCockpit/cc-backend/internal/metricDataDispatcher"
 	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
 	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/internal/webhook"
 	"github.com/ClusterCockpit/cc-backend/pkg/archive"
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 	"github.com/gorilla/mux"
+	"github.com/parquet-go/parquet-go"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -157,6 +167,9 @@ func setup(t *testing.T) *api.RestApi {
 	}
 
 	archiver.Start(repository.GetJobRepository())
+	if err := webhook.Start(); err != nil {
+		t.Fatal(err)
+	}
 	auth.Init()
 	graph.Init()
 
@@ -260,6 +273,16 @@ func TestRestApi(t *testing.T) {
 			t.Fatal(err)
 		}
 
+		// Jobs are not counted for cursor pagination
+		cursor := ""
+		list, err := resolver.Query().Jobs(ctx, nil, &model.PageRequest{ItemsPerPage: 10, Cursor: &cursor}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list.Items) == 0 || list.Count != nil {
+			t.Errorf("unexpected job list: %#v", list)
+		}
+
 		if job.JobID != 123 ||
 			job.User != "testuser" ||
 			job.Project != "testproj" ||
@@ -336,6 +359,26 @@ func TestRestApi(t *testing.T) {
 		return
 	}
 
+	t.Run("CheckJobEvents", func(t *testing.T) {
+		events, err := restapi.JobRepository.GetJobEvents(stoppedJob.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []schema.JobEventType{schema.JobEventStart, schema.JobEventTag, schema.JobEventStop, schema.JobEventArchived}
+		if len(events) != len(expected) {
+			t.Fatalf("unexpected job events: %#v", events)
+		}
+		for i, event := range events {
+			if event.Event != expected[i] {
+				t.Errorf("expected event %d to be '%s', got '%s'", i, expected[i], event.Event)
+			}
+		}
+		if events[2].State == nil || *events[2].State != schema.JobStateCompleted || events[2].Actor != "testuser" {
+			t.Errorf("unexpected stop event: %#v", events[2])
+		}
+	})
+
 	t.Run("CheckArchive", func(t *testing.T) {
 		data, err := metricDataDispatcher.LoadData(stoppedJob, []string{"load_one"}, []schema.MetricScope{schema.MetricScopeNode}, context.Background(), 60)
 		if err != nil {
@@ -347,6 +390,91 @@ func TestRestApi(t *testing.T) {
 		}
 	})
 
+	t.Run("CheckJobComments", func(t *testing.T) {
+		from, to := int64(123456800), int64(123456860)
+		comment, err := restapi.JobRepository.AddComment(contextUserValue, stoppedJob.ID,
+			&schema.JobComment{Text: "load drops", Metric: "load_one", From: &from, To: &to})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = restapi.JobRepository.AddComment(contextUserValue, stoppedJob.ID,
+			&schema.JobComment{Text: "expected, IO phase", Scope: "testuser", ParentID: &comment.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := restapi.JobRepository.AddComment(contextUserValue, stoppedJob.ID,
+			&schema.JobComment{Text: "not allowed", Scope: "admin"}); err == nil {
+			t.Fatal("expected error when writing a comment with admin scope")
+		}
+
+		// Replies need a readable parent on the same job
+		adminUser := &schema.User{Username: "admin", Roles: []string{"admin"}}
+		adminComment, err := restapi.JobRepository.AddComment(adminUser, stoppedJob.ID,
+			&schema.JobComment{Text: "admins only", Scope: "admin"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := restapi.JobRepository.AddComment(contextUserValue, stoppedJob.ID,
+			&schema.JobComment{Text: "not allowed", ParentID: &adminComment.ID}); err == nil {
+			t.Fatal("expected error when replying to a comment that is not readable")
+		}
+		if err := restapi.JobRepository.DeleteComment(adminUser, adminComment.ID); err != nil {
+			t.Fatal(err)
+		}
+		var other schema.JobMeta
+		if err := json.Unmarshal([]byte(startJobBody), &other); err != nil {
+			t.Fatal(err)
+		}
+		other.JobID, other.State = 1036, schema.JobStateRunning
+		otherId, err := restapi.JobRepository.Start(contextUserValue, &other)
+		if err != nil {
+			t.Fatal(err)
+		}
+		otherComment, err := restapi.JobRepository.AddComment(contextUserValue, otherId,
+			&schema.JobComment{Text: "on another job"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := restapi.JobRepository.AddComment(contextUserValue, stoppedJob.ID,
+			&schema.JobComment{Text: "not allowed", ParentID: &otherComment.ID}); err == nil {
+			t.Fatal("expected error when replying to a comment on another job")
+		}
+		if _, err := restapi.JobRepository.DB.Exec("DELETE FROM job WHERE id = ?", otherId); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := restapi.JobRepository.UpdateComment(contextUserValue, comment.ID, "load drops to zero"); err != nil {
+			t.Fatal(err)
+		}
+		noAccess := &schema.User{Username: contextUserValue.Username}
+		if _, err := restapi.JobRepository.UpdateComment(noAccess, comment.ID, "changed without access"); err == nil {
+			t.Fatal("expected error when editing a comment without access to the job")
+		}
+
+		comments, err := restapi.JobRepository.GetComments(contextUserValue, stoppedJob.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(comments) != 2 || comments[0].Text != "load drops to zero" || comments[0].Scope != "global" ||
+			comments[1].ParentID == nil || *comments[1].ParentID != comment.ID {
+			t.Fatalf("unexpected comments: %#v", comments)
+		}
+
+		jobMeta, err := archive.GetHandle().LoadJobMeta(stoppedJob)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(jobMeta.Comments) != 2 || jobMeta.Comments[0].Author != "testuser" || *jobMeta.Comments[0].From != from {
+			t.Fatalf("unexpected comments in archive: %#v", jobMeta.Comments)
+		}
+
+		if err := restapi.JobRepository.DeleteComment(contextUserValue, comment.ID); err != nil {
+			t.Fatal(err)
+		}
+		if comments, err := restapi.JobRepository.GetComments(contextUserValue, stoppedJob.ID); err != nil || len(comments) != 0 {
+			t.Fatalf("expected replies to be deleted with the comment: %#v %v", comments, err)
+		}
+	})
+
 	t.Run("CheckDoubleStart", func(t *testing.T) {
 		// Starting a job with the same jobId and cluster should only be allowed if the startTime is far appart!
 		body := strings.Replace(startJobBody, `"startTime": 123456789`, `"startTime": 123456790`, -1)
@@ -434,4 +562,1338 @@ func TestRestApi(t *testing.T) {
 	if !ok {
 		t.Fatal("subtest failed")
 	}
+
+	const nodeStateBody string = `{
+		"cluster":   "testcluster",
+		"timeStamp": 1000,
+		"nodes": [
+			{ "hostname": "host123", "nodeState": "allocated", "healthState": "full" },
+			{ "hostname": "host124", "nodeState": "drain", "healthState": "partial", "reason": "Kill task failed",
+			  "inventory": { "memory": "64GB" } }
+		]
+	}`
+
+	const nodeStateUpdateBody string = `{
+		"cluster":   "testcluster",
+		"timeStamp": 2000,
+		"nodes": [
+			{ "hostname": "host123", "nodeState": "allocated", "healthState": "full" },
+			{ "hostname": "host124", "nodeState": "down", "healthState": "failed", "reason": "Not responding" }
+		]
+	}`
+
+	t.Run("UpdateNodeState", func(t *testing.T) {
+		for _, body := range []string{nodeStateBody, nodeStateUpdateBody} {
+			req := httptest.NewRequest(http.MethodPost, "/nodestate/", bytes.NewBuffer([]byte(body)))
+			recorder := httptest.NewRecorder()
+
+			r.ServeHTTP(recorder, req)
+			response := recorder.Result()
+			if response.StatusCode != http.StatusOK {
+				t.Fatal(response.Status, recorder.Body.String())
+			}
+		}
+
+		// Nothing is updated if one of the nodes is invalid
+		invalidBody := `{"cluster": "testcluster", "timeStamp": 3000, "nodes": [
+			{ "hostname": "host123", "nodeState": "idle" }, { "hostname": "host124", "nodeState": "sleeping" }]}`
+		recorder := httptest.NewRecorder()
+		r.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/nodestate/", strings.NewReader(invalidBody)))
+		if recorder.Code != http.StatusBadRequest {
+			t.Fatalf("expected update with invalid node state to fail: %d", recorder.Code)
+		}
+
+		// Updates older than the stored state are skipped
+		outdatedBody := `{"cluster": "testcluster", "timeStamp": 1500, "nodes": [{ "hostname": "host124", "nodeState": "idle" }]}`
+		recorder = httptest.NewRecorder()
+		r.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/nodestate/", strings.NewReader(outdatedBody)))
+		if recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+
+		repo := repository.GetNodeRepository()
+		node, err := repo.FindNode("testcluster", "host124")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if node.SubCluster != "sc1" || node.NodeState != schema.NodeStateDown || node.StateSince != 2000 ||
+			node.Reason != "Not responding" || node.Inventory["memory"] != "64GB" {
+			t.Errorf("unexpected node state: %#v", node)
+		}
+
+		history, err := repo.NodeStateHistory("testcluster", "host123", 0, 3000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(history) != 1 || history[0].TimeStamp != 1000 {
+			t.Errorf("unexpected state history of unchanged node: %#v", history)
+		}
+
+		history, err = repo.NodeStateHistory("testcluster", "host124", 1500, 3000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(history) != 2 || history[0].NodeState != schema.NodeStateDrain || history[1].NodeState != schema.NodeStateDown {
+			t.Errorf("unexpected state history: %#v", history)
+		}
+	})
+
+	t.Run("CheckAuditLog", func(t *testing.T) {
+		repo := repository.GetAuditRepository()
+		entries, err := repo.Query(&repository.AuditFilter{Action: "start_job"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) == 0 {
+			t.Fatal("expected the job starts in the audit log")
+		}
+		if e := entries[len(entries)-1]; e.Action != "POST /jobs/start_job/" || e.Status != http.StatusCreated || !strings.Contains(e.Payload, `"jobId":123`) {
+			t.Errorf("unexpected audit log entry: %#v", e)
+		}
+
+		entries, err = repo.Query(&repository.AuditFilter{Action: "nodestate"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) == 0 {
+			t.Fatal("expected the node state updates in the audit log")
+		}
+		e := entries[len(entries)-1]
+		if e.Action != "POST /nodestate/" || e.Status != http.StatusOK || !strings.Contains(e.Payload, "host124") {
+			t.Errorf("unexpected audit log entry: %#v", e)
+		}
+
+		// Nested secrets are redacted, compressed bodies are not recorded
+		compressed := &bytes.Buffer{}
+		zw := gzip.NewWriter(compressed)
+		zw.Write([]byte(`{"password": "hunter2"}`))
+		zw.Close()
+		for _, body := range []io.Reader{
+			strings.NewReader(`{"nodes": [{"hostname": "host125", "credentials": {"password": "hunter2"}}]}`),
+			compressed,
+		} {
+			req := httptest.NewRequest(http.MethodPost, "/nodestate/", body)
+			r.ServeHTTP(httptest.NewRecorder(), req)
+		}
+		if entries, err = repo.Query(&repository.AuditFilter{Action: "nodestate"}, nil); err != nil {
+			t.Fatal(err)
+		}
+		if p := entries[1].Payload; strings.Contains(p, "hunter2") || !strings.Contains(p, "host125") {
+			t.Errorf("expected nested secret to be redacted: %s", p)
+		}
+		if p := entries[0].Payload; p != "(compressed body not recorded)" {
+			t.Errorf("unexpected payload of compressed body: %s", p)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/audit/?page=0", nil)
+		recorder := httptest.NewRecorder()
+		r.ServeHTTP(recorder, req)
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected page 0 to fail: %d", recorder.Code)
+		}
+
+		if _, err := repo.DB.Exec("DELETE FROM audit_log"); err == nil {
+			t.Error("expected the audit log to be append-only")
+		}
+	})
+
+	t.Run("SavedJobFilters", func(t *testing.T) {
+		repo := repository.GetSavedFilterRepository()
+		ctx := context.WithValue(context.Background(), contextUserKey, contextUserValue)
+
+		failed := []*model.JobFilter{{State: []schema.JobState{schema.JobStateFailed}}}
+		if _, err := repo.Save(contextUserValue, "failed jobs", "global", "", failed); err == nil {
+			t.Fatal("expected error when sharing a filter globally as user")
+		}
+		saved, err := repo.Save(contextUserValue, "failed jobs", "", "", failed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if saved.Shared != "private" || saved.Owner != "testuser" {
+			t.Fatalf("unexpected saved filter: %#v", saved)
+		}
+
+		name := "failed jobs"
+		filter, err := repo.Expand(contextUserValue, []*model.JobFilter{{SavedFilter: &name}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		count, err := restapi.JobRepository.CountJobs(ctx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Errorf("expected 1 failed job, got %d", count)
+		}
+
+		other := &schema.User{Username: "otheruser", Roles: []string{"user"}}
+		if _, err := repo.Expand(other, []*model.JobFilter{{SavedFilter: &name}}); err == nil {
+			t.Error("expected private filter to be invisible to other users")
+		}
+
+		if err := repo.Delete(other, saved.ID); err == nil {
+			t.Error("expected error when deleting a filter of another user")
+		}
+		if err := repo.Delete(contextUserValue, saved.ID); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("ProjectAllocations", func(t *testing.T) {
+		repo := repository.GetAllocationRepository()
+		budget := 1.0
+		a, err := repo.Add(&schema.Allocation{
+			Project:   "testproj",
+			Cluster:   "testcluster",
+			StartTime: time.Unix(0, 0),
+			EndTime:   time.Now().Add(24 * time.Hour),
+			CoreHours: &budget,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := repo.List(contextUserValue, nil, nil, nil); err == nil {
+			t.Error("expected error when listing allocations as user")
+		}
+
+		overlapping := &schema.Allocation{
+			Project:   "testproj",
+			Cluster:   "testcluster",
+			StartTime: time.Now(),
+			EndTime:   time.Now().Add(48 * time.Hour),
+		}
+		if _, err := repo.Add(overlapping); err == nil {
+			t.Fatal("expected error when adding an overlapping allocation")
+		}
+		overlapping.StartTime = a.EndTime
+		next, err := repo.Add(overlapping)
+		if err != nil {
+			t.Fatal(err)
+		}
+		next.StartTime = a.EndTime.Add(-time.Hour)
+		if _, err := repo.Update(next); err == nil {
+			t.Fatal("expected error when moving an allocation into another one")
+		}
+		if err := repo.Delete(next.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := repo.Update(a); err != nil {
+			t.Fatal(err)
+		}
+
+		usage, err := repo.Usage(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// stoppedJob: 8 hwthreads for 1000 seconds
+		if usage.NumJobs != 2 || usage.CoreHours < 8000.0/3600 || usage.RemainingCoreHours == nil ||
+			*usage.RemainingCoreHours != budget-usage.CoreHours || usage.RemainingAccHours != nil || usage.CoreHoursPerDay <= 0 {
+			t.Fatalf("unexpected allocation usage: %#v", usage)
+		}
+
+		tagged := make([]*schema.Job, 0)
+		n, err := repo.TagOverBudgetJobs(24*time.Hour, func(job *schema.Job, tag *schema.Tag) {
+			if tag.Type != repository.OverBudgetTagType || tag.ID == 0 {
+				t.Errorf("unexpected tag: %#v", tag)
+			}
+			tagged = append(tagged, job)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n == 0 || len(tagged) != n {
+			t.Fatalf("expected jobs to be tagged over budget: %d (%d)", n, len(tagged))
+		}
+		if n, err := repo.TagOverBudgetJobs(24*time.Hour, nil); err != nil || n != 0 {
+			t.Fatalf("expected tagged jobs to be skipped: %d %v", n, err)
+		}
+
+		tags, err := restapi.JobRepository.GetArchiveTags(&stoppedJob.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := false
+		for _, tag := range tags {
+			found = found || (tag.Type == repository.OverBudgetTagType && tag.Name == repository.OverBudgetTagName)
+		}
+		if !found {
+			t.Errorf("expected job over budget to be tagged: %#v", tags)
+		}
+	})
+
+	t.Run("UsageRollup", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), contextUserKey, contextUserValue)
+		groupBy := model.AggregateUser
+		filter := []*model.JobFilter{{Cluster: &model.StringInput{Eq: &TestClusterName}}}
+
+		expected, err := restapi.JobRepository.JobsStatsGrouped(ctx, filter, nil, nil, &groupBy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectedTotal, err := restapi.JobRepository.JobsStats(ctx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		config.Keys.EnableUsageRollup = true
+		defer func() { config.Keys.EnableUsageRollup = false }()
+
+		// The second update only aggregates the days of recently stopped jobs
+		for i := 0; i < 2; i++ {
+			if err := restapi.JobRepository.UpdateUsageRollup(); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var rows int
+		if err := restapi.JobRepository.DB.QueryRow("SELECT COUNT(*) FROM job_usage_daily").Scan(&rows); err != nil {
+			t.Fatal(err)
+		}
+		if rows == 0 {
+			t.Fatal("expected usage aggregates")
+		}
+
+		stats, err := restapi.JobRepository.JobsStatsGrouped(ctx, filter, nil, nil, &groupBy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(stats, expected) {
+			t.Errorf("grouped statistics differ with usage rollup: %#v != %#v", stats[0], expected[0])
+		}
+		total, err := restapi.JobRepository.JobsStats(ctx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(total, expectedTotal) {
+			t.Errorf("statistics differ with usage rollup: %#v != %#v", total[0], expectedTotal[0])
+		}
+	})
+
+	t.Run("ClusterUtilization", func(t *testing.T) {
+		apiUser := &schema.User{Username: "apiuser", Roles: []string{"api"}}
+		get := func(query string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/clusters/utilization/testcluster?"+query, nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, apiUser)))
+			return recorder
+		}
+
+		recorder := get("time=123456000-123463200&bucket-size=3600")
+		if recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		var res api.GetClusterUtilizationApiResponse
+		if err := json.NewDecoder(recorder.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+
+		// stoppedJob: 1 node and 8 hwthreads for 1000 seconds of the first hour
+		if len(res.Utilization) != 2 {
+			t.Fatalf("expected two buckets: %#v", res.Utilization)
+		}
+		first, second := res.Utilization[0], res.Utilization[1]
+		if first.Time.Unix() != 123456000 || math.Abs(first.Nodes-1000.0/3600) > 1e-9 ||
+			math.Abs(first.Cores-8000.0/3600) > 1e-9 || math.Abs(first.Jobs-1000.0/3600) > 1e-9 || first.Accs != 0 {
+			t.Errorf("unexpected utilization: %#v", first)
+		}
+		if second.Time.Unix() != 123459600 || second.Nodes != 0 || second.Cores != 0 || second.Jobs != 0 {
+			t.Errorf("unexpected utilization: %#v", second)
+		}
+
+		if recorder := get("time=123456000-123463200&bucket-size=0"); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected invalid bucket size to fail: %d", recorder.Code)
+		}
+		if recorder := get("bucket-size=60"); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected missing time range to fail: %d", recorder.Code)
+		}
+
+		// Running jobs starting in the future do not count
+		now := time.Now().Unix()
+		startBody := fmt.Sprintf(`{
+			"jobId": 4001, "user": "testuser", "project": "testproj", "cluster": "testcluster",
+			"partition": "future", "numNodes": 1, "numHwthreads": 8, "exclusive": 1,
+			"resources": [{ "hostname": "host123", "hwthreads": [0, 1, 2, 3, 4, 5, 6, 7] }],
+			"startTime": %d
+		}`, now+300)
+		req := httptest.NewRequest(http.MethodPost, "/jobs/start_job/", strings.NewReader(startBody))
+		recorder = httptest.NewRecorder()
+		r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, contextUserValue)))
+		if recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		defer repository.GetConnection().DB.Exec("DELETE FROM job WHERE job_id = 4001")
+
+		partition := "future"
+		points, err := restapi.JobRepository.ClusterUtilization("testcluster", nil, &partition,
+			time.Unix(now-3000, 0), time.Unix(now+4200, 0), 3600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range points {
+			if p.Jobs != 0 || p.Nodes != 0 || p.Cores != 0 {
+				t.Errorf("unexpected utilization: %#v", p)
+			}
+		}
+	})
+
+	t.Run("Metrics", func(t *testing.T) {
+		scrape := func(handler http.Handler, token string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			return recorder
+		}
+
+		if recorder := scrape(api.MetricsHandler(&schema.MetricsEndpointConfig{}), ""); recorder.Code != http.StatusOK ||
+			!strings.Contains(recorder.Body.String(), "cc_backend_metric_data_disk_cache_hits_total") {
+			t.Errorf("unexpected metrics: %d %s", recorder.Code, recorder.Body.String())
+		}
+
+		handler := api.MetricsHandler(&schema.MetricsEndpointConfig{Token: "scraper"})
+		for token, code := range map[string]int{"": http.StatusUnauthorized, "other": http.StatusUnauthorized, "scraper": http.StatusOK} {
+			if recorder := scrape(handler, token); recorder.Code != code {
+				t.Errorf("token %q: expected %d, got %d", token, code, recorder.Code)
+			}
+		}
+	})
+
+	t.Run("ExportJobs", func(t *testing.T) {
+		post := func(body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/jobs/export/", strings.NewReader(body))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, contextUserValue)))
+			return recorder
+		}
+		export := func(format string) *httptest.ResponseRecorder {
+			return post(fmt.Sprintf(`{
+				"filter":  [{ "jobId": { "eq": "123" }, "cluster": { "eq": "testcluster" } }],
+				"format":  %q,
+				"columns": ["jobId", "user", "nodes", "numHwthreads", "meta.jobScript", "footprint.unknown"]
+			}`, format))
+		}
+
+		recorder := export("csv")
+		if recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		expected := "jobId,user,nodes,numHwthreads,meta.jobScript,footprint.unknown\n123,testuser,host123,8,blablabla...,\n"
+		if recorder.Body.String() != expected {
+			t.Errorf("unexpected csv export: %q", recorder.Body.String())
+		}
+
+		recorder = export("ndjson")
+		var record map[string]any
+		if err := json.NewDecoder(recorder.Body).Decode(&record); err != nil {
+			t.Fatal(err)
+		}
+		if record["jobId"] != 123.0 || record["meta.jobScript"] != "blablabla..." || record["footprint.unknown"] != nil {
+			t.Errorf("unexpected ndjson export: %#v", record)
+		}
+
+		recorder = export("parquet")
+		data := recorder.Body.Bytes()
+		f, err := parquet.OpenFile(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.NumRows() != 1 || len(f.Schema().Columns()) != 6 {
+			t.Errorf("unexpected parquet export: %d rows, columns %v", f.NumRows(), f.Schema().Columns())
+		}
+
+		if recorder := export("xlsx"); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected unknown format to fail: %d", recorder.Code)
+		}
+
+		// The error is reported before the export starts
+		recorder = post(`{"columns": ["jobId"], "order": {"field": "startTime", "type": "col", "order": "SIDEWAYS"}}`)
+		if recorder.Code != http.StatusBadRequest || recorder.Header().Get("Content-Type") == "text/csv" {
+			t.Errorf("expected invalid order to fail: %d %s", recorder.Code, recorder.Body.String())
+		}
+
+		recorder = post(`{"filter": [{ "jobId": { "eq": "999999" } }], "columns": ["jobId", "user"]}`)
+		if recorder.Code != http.StatusOK || recorder.Body.String() != "jobId,user\n" {
+			t.Errorf("expected csv header without jobs: %d %q", recorder.Code, recorder.Body.String())
+		}
+	})
+
+	t.Run("JobCollections", func(t *testing.T) {
+		repo := repository.GetCollectionRepository()
+		other := &schema.User{Username: "otheruser", Roles: []string{"user"}}
+		third := &schema.User{Username: "thirduser", Roles: []string{"user"}}
+
+		job, err := restapi.JobRepository.Find(&TestJobId, &TestClusterName, &TestStartTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		collection, err := repo.Create(contextUserValue, "benchmark runs", "runs for the paper")
+		if err != nil {
+			t.Fatal(err)
+		}
+		userCtx := context.WithValue(context.Background(), contextUserKey, contextUserValue)
+		otherCtx := context.WithValue(context.Background(), contextUserKey, other)
+		if _, err := repo.AddJobs(otherCtx, collection.ID, []int64{job.ID}); err == nil {
+			t.Fatal("expected error when adding jobs to a collection of another user")
+		}
+		tokenCtx := context.WithValue(userCtx, repository.ContextApiTokenKey, &schema.ApiToken{Clusters: []string{"othercluster"}})
+		if _, err := repo.AddJobs(tokenCtx, collection.ID, []int64{job.ID}); err == nil {
+			t.Fatal("expected error when adding jobs of clusters the api token is not restricted to")
+		}
+		if collection, err = repo.AddJobs(userCtx, collection.ID, []int64{job.ID, job.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if collection.NumJobs != 1 {
+			t.Errorf("expected 1 job in collection, got %d", collection.NumJobs)
+		}
+
+		cid := strconv.FormatInt(collection.ID, 10)
+		filter := []*model.JobFilter{{Collection: &cid}}
+		if count, err := restapi.JobRepository.CountJobs(otherCtx, filter); err != nil || count != 0 {
+			t.Fatalf("expected jobs of unshared collection to be hidden: %d %v", count, err)
+		}
+		if err := repo.CheckFilter(other, filter); err == nil {
+			t.Error("expected unshared collection to be invisible to other users")
+		}
+
+		if collection, err = repo.Share(contextUserValue, collection.ID, []string{"otheruser"}, nil); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(collection.SharedUsers, []string{"otheruser"}) {
+			t.Errorf("unexpected shares: %#v", collection.SharedUsers)
+		}
+		if err := repo.CheckFilter(other, filter); err != nil {
+			t.Fatal(err)
+		}
+		stats, err := restapi.JobRepository.JobsStats(otherCtx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(stats) != 1 || stats[0].TotalJobs != 1 {
+			t.Errorf("unexpected collection statistics: %#v", stats)
+		}
+		if _, err := repo.Update(other, collection.ID, nil, nil); err == nil {
+			t.Error("expected shared collection to be read-only")
+		}
+		reshared, err := repo.Create(other, "reshared", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := repo.AddJobs(otherCtx, reshared.ID, []int64{job.ID}); err == nil {
+			t.Error("expected jobs of shared collections not to be added to other collections")
+		}
+		if err := repo.Delete(other, reshared.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.CheckFilter(third, filter); err == nil {
+			t.Error("expected collection to be invisible to users it is not shared with")
+		}
+
+		if err := repo.Delete(contextUserValue, collection.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := restapi.JobRepository.Find(&TestJobId, &TestClusterName, &TestStartTime); err != nil {
+			t.Fatalf("expected job to be kept: %v", err)
+		}
+	})
+
+	t.Run("SoftDeleteJobs", func(t *testing.T) {
+		adminUser := &schema.User{Username: "admin", Roles: []string{"admin", "api"}}
+		call := func(method, path string, user *schema.User) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, path, nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, user)))
+			return recorder
+		}
+
+		job, err := restapi.JobRepository.Find(&TestJobId, &TestClusterName, &TestStartTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if recorder := call(http.MethodDelete, fmt.Sprintf("/jobs/delete_job/%d", job.ID), adminUser); recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		if _, err := restapi.JobRepository.Find(&TestJobId, &TestClusterName, &TestStartTime); err != sql.ErrNoRows {
+			t.Fatalf("expected deleted job to be hidden: %v", err)
+		}
+
+		// Deleted jobs can be restored, so they still count as duplicates
+		req := httptest.NewRequest(http.MethodPost, "/jobs/start_job/", bytes.NewBuffer([]byte(startJobBody)))
+		recorder := httptest.NewRecorder()
+		r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, contextUserValue)))
+		if recorder.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("expected starting a duplicate of a deleted job to fail: %d %s", recorder.Code, recorder.Body.String())
+		}
+
+		// A live duplicate, e.g. from before the check, prevents the restore
+		var dup schema.JobMeta
+		if err := json.Unmarshal([]byte(startJobBody), &dup); err != nil {
+			t.Fatal(err)
+		}
+		dup.State = schema.JobStateCompleted
+		dup.StartTime += 3600
+		dupId, err := restapi.JobRepository.Start(adminUser, &dup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if recorder := call(http.MethodPost, fmt.Sprintf("/jobs/restore_job/%d", job.ID), adminUser); recorder.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected restoring a job with a live duplicate to fail: %d", recorder.Code)
+		}
+		if _, err := restapi.JobRepository.DB.Exec("DELETE FROM job WHERE id = ?", dupId); err != nil {
+			t.Fatal(err)
+		}
+
+		if recorder := call(http.MethodGet, "/jobs/deleted/", contextUserValue); recorder.Code != http.StatusForbidden {
+			t.Errorf("expected listing deleted jobs to require admin role: %d", recorder.Code)
+		}
+		recorder = call(http.MethodGet, "/jobs/deleted/", adminUser)
+		if recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		var res api.GetDeletedJobsApiResponse
+		if err := json.NewDecoder(recorder.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Jobs) != 1 || res.Jobs[0].ID != job.ID || res.Jobs[0].DeletedBy != "admin" {
+			t.Fatalf("unexpected deleted jobs: %#v", res.Jobs)
+		}
+
+		if recorder := call(http.MethodPost, fmt.Sprintf("/jobs/restore_job/%d", job.ID), adminUser); recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		if _, err := restapi.JobRepository.Find(&TestJobId, &TestClusterName, &TestStartTime); err != nil {
+			t.Fatalf("expected restored job to be visible: %v", err)
+		}
+		if recorder := call(http.MethodPost, fmt.Sprintf("/jobs/restore_job/%d", job.ID), adminUser); recorder.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected restoring a job that is not deleted to fail: %d", recorder.Code)
+		}
+
+		// Only jobs deleted before the grace period are purged
+		if err := restapi.JobRepository.DeleteJobById(job.ID, adminUser); err != nil {
+			t.Fatal(err)
+		}
+		purged, err := restapi.JobRepository.PurgeDeletedJobs(time.Now().Unix() - 3600)
+		if err != nil || len(purged) != 0 {
+			t.Fatalf("expected no jobs to be purged: %v %v", purged, err)
+		}
+		purged, err = restapi.JobRepository.PurgeDeletedJobs(time.Now().Unix() + 3600)
+		if err != nil || len(purged) != 1 || purged[0].ID != job.ID {
+			t.Fatalf("expected job to be purged: %v %v", purged, err)
+		}
+		if recorder := call(http.MethodPost, fmt.Sprintf("/jobs/restore_job/%d", job.ID), adminUser); recorder.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected restoring a purged job to fail: %d", recorder.Code)
+		}
+
+		// Running jobs cannot be deleted, they could not be stopped anymore
+		running := strings.Replace(startJobBody, `"jobId":            123`, `"jobId": 1053`, 1)
+		req = httptest.NewRequest(http.MethodPost, "/jobs/start_job/", strings.NewReader(running))
+		recorder = httptest.NewRecorder()
+		r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, contextUserValue)))
+		if recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		runningId := int64(1053)
+		started, err := restapi.JobRepository.Find(&runningId, &TestClusterName, &TestStartTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if recorder := call(http.MethodDelete, fmt.Sprintf("/jobs/delete_job/%d", started.ID), adminUser); recorder.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected deleting a running job to fail: %d", recorder.Code)
+		}
+		stop := `{"jobId": 1053, "cluster": "testcluster", "startTime": 123456789, "jobState": "completed", "stopTime": 123457789}`
+		req = httptest.NewRequest(http.MethodPost, "/jobs/stop_job/", strings.NewReader(stop))
+		recorder = httptest.NewRecorder()
+		r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, contextUserValue)))
+		if recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+	})
+
+	t.Run("QueueWaitTimes", func(t *testing.T) {
+		start := func(jobId int64, submitTime int64, eligibleTime int64) *httptest.ResponseRecorder {
+			body := fmt.Sprintf(`{
+				"jobId": %d, "user": "testuser", "project": "testproj", "cluster": "testcluster",
+				"partition": "default", "numNodes": 1, "numHwthreads": 8, "exclusive": 1,
+				"resources": [{ "hostname": "host123", "hwthreads": [0, 1, 2, 3, 4, 5, 6, 7] }],
+				"submitTime": %d, "eligibleTime": %d, "startTime": 1700000000
+			}`, jobId, submitTime, eligibleTime)
+			req := httptest.NewRequest(http.MethodPost, "/jobs/start_job/", strings.NewReader(body))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, contextUserValue)))
+			return recorder
+		}
+
+		if recorder := start(4711, 1700000100, 0); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected submit time after start time to fail: %d", recorder.Code)
+		}
+		if recorder := start(4711, 1699996400, 1699990000); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected eligible time before submit time to fail: %d", recorder.Code)
+		}
+		if recorder := start(4711, 1699996400, 1699998200); recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+
+		jobId, cluster, startTime := int64(4711), "testcluster", int64(1700000000)
+		job, err := restapi.JobRepository.Find(&jobId, &cluster, &startTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.SubmitTime != 1699996400 || job.EligibleTime != 1699998200 {
+			t.Errorf("unexpected queue times: %d %d", job.SubmitTime, job.EligibleTime)
+		}
+
+		ctx := context.WithValue(context.Background(), contextUserKey, contextUserValue)
+		filter := []*model.JobFilter{{WaitTime: &schema.IntRange{From: 3000, To: 4000}}}
+		if count, err := restapi.JobRepository.CountJobs(ctx, filter); err != nil || count != 1 {
+			t.Fatalf("expected one job waiting for an hour: %d %v", count, err)
+		}
+
+		groupBy := model.AggregatePartition
+		stats := []*model.JobsStatistics{{ID: "default"}}
+		if stats, err = restapi.JobRepository.AddWaitTimes(ctx, filter, &groupBy, stats); err != nil {
+			t.Fatal(err)
+		}
+		bins := "1h"
+		if stats, err = restapi.JobRepository.AddWaitTimeHistograms(ctx, nil, &groupBy, stats, &bins); err != nil {
+			t.Fatal(err)
+		}
+		if stats[0].AvgWaitTime != 3600 || stats[0].MaxWaitTime != 3600 {
+			t.Errorf("unexpected wait times: %#v", stats[0])
+		}
+		if len(stats[0].HistWaitTime) != 48 || stats[0].HistWaitTime[1].Count != 1 {
+			t.Errorf("unexpected wait time histogram: %v", stats[0].HistWaitTime)
+		}
+
+		order := &model.OrderByInput{Field: "waitTime", Type: "col", Order: model.SortDirectionEnumDesc}
+		jobs, err := restapi.JobRepository.QueryJobs(ctx, nil, nil, order)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(jobs) == 0 || jobs[0].JobID != 4711 {
+			t.Errorf("expected job with the longest wait time first: %v", jobs)
+		}
+	})
+
+	t.Run("BulkStartStopJobs", func(t *testing.T) {
+		post := func(path string, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, contextUserValue)))
+			return recorder
+		}
+		job := func(jobId int64) string {
+			return fmt.Sprintf(`{
+				"jobId": %d, "user": "testuser", "project": "testproj", "cluster": "testcluster",
+				"partition": "default", "numNodes": 1, "numHwthreads": 8, "exclusive": 1,
+				"resources": [{ "hostname": "host123", "hwthreads": [0, 1, 2, 3, 4, 5, 6, 7] }],
+				"tags": [{ "type": "bulk", "name": "testtag", "scope": "testuser" }],
+				"startTime": 1710000000
+			}`, jobId)
+		}
+
+		recorder := post("/jobs/start_jobs/", "["+strings.Join([]string{job(5001), job(5002), job(5001), `{"jobId": 5003}`}, ",")+"]")
+		if recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		var started api.BulkJobApiResponse
+		if err := json.NewDecoder(recorder.Body).Decode(&started); err != nil {
+			t.Fatal(err)
+		}
+		if len(started.Results) != 4 || started.Results[0].Status != http.StatusCreated || started.Results[1].Status != http.StatusCreated ||
+			started.Results[2].Status != http.StatusUnprocessableEntity || started.Results[3].Status != http.StatusBadRequest {
+			t.Fatalf("unexpected results: %#v", started.Results)
+		}
+
+		stop := `[
+			{ "jobId": 5001, "cluster": "testcluster", "startTime": 1710000000, "jobState": "completed", "stopTime": 1710003600 },
+			{ "jobId": 5002, "cluster": "testcluster", "startTime": 1710000000, "jobState": "failed", "stopTime": 1710000600 },
+			{ "jobId": 5001, "cluster": "testcluster", "startTime": 1710000000, "jobState": "completed", "stopTime": 1710003600 },
+			{ "jobId": 5009, "cluster": "testcluster", "stopTime": 1710003600 }
+		]`
+		recorder = post("/jobs/stop_jobs/", stop)
+		if recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		var stopped api.BulkJobApiResponse
+		if err := json.NewDecoder(recorder.Body).Decode(&stopped); err != nil {
+			t.Fatal(err)
+		}
+		if len(stopped.Results) != 4 || stopped.Results[0].Status != http.StatusOK || stopped.Results[1].Status != http.StatusOK ||
+			stopped.Results[2].Status != http.StatusBadRequest || stopped.Results[3].Status != http.StatusUnprocessableEntity {
+			t.Fatalf("unexpected results: %#v", stopped.Results)
+		}
+
+		archiver.WaitForArchiving()
+		for i, state := range []schema.JobState{schema.JobStateCompleted, schema.JobStateFailed} {
+			job, err := restapi.JobRepository.FindById(context.WithValue(context.Background(), contextUserKey, contextUserValue), started.Results[i].DBID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if job.State != state || job.MonitoringStatus != schema.MonitoringStatusArchivingSuccessful {
+				t.Errorf("unexpected job properties: %#v", job)
+			}
+			tags, err := restapi.JobRepository.GetTags(contextUserValue, &job.ID)
+			if err != nil || len(tags) != 1 || tags[0].Type != "bulk" {
+				t.Errorf("unexpected tags: %#v %v", tags, err)
+			}
+		}
+	})
+
+	t.Run("UpdateJob", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), contextUserKey, contextUserValue)
+		patch := func(id int64, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/jobs/update_job/%d", id), strings.NewReader(body))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req.WithContext(ctx))
+			return recorder
+		}
+
+		body := `{
+			"jobId": 5101, "user": "testuser", "project": "testproj", "cluster": "testcluster",
+			"partition": "default", "numNodes": 1, "numHwthreads": 8, "exclusive": 1, "walltime": 3600,
+			"resources": [{ "hostname": "host123", "hwthreads": [0, 1, 2, 3, 4, 5, 6, 7] }],
+			"startTime": 1720000000
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/jobs/start_job/", strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		r.ServeHTTP(recorder, req.WithContext(ctx))
+		if recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		jobId, cluster := int64(5101), "testcluster"
+		job, err := restapi.JobRepository.Find(&jobId, &cluster, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if recorder := patch(job.ID, `{}`); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected empty update to fail: %d", recorder.Code)
+		}
+		if recorder := patch(job.ID, `{"resources": []}`); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected update without resources to fail: %d", recorder.Code)
+		}
+		recorder = patch(job.ID, `{
+			"walltime": 7200,
+			"resources": [
+				{ "hostname": "host123", "hwthreads": [0, 1, 2, 3, 4, 5, 6, 7] },
+				{ "hostname": "host124", "hwthreads": [0, 1, 2, 3] }
+			]
+		}`)
+		if recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+
+		job, err = restapi.JobRepository.FindById(ctx, job.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.Walltime != 7200 || job.NumNodes != 2 || job.NumHWThreads != 12 || len(job.Resources) != 2 || job.Partition != "default" {
+			t.Errorf("unexpected job properties: %#v", job)
+		}
+
+		// Only hostnames: the job has whole nodes of the subcluster
+		recorder = patch(job.ID, `{"resources": [{ "hostname": "host123" }, { "hostname": "host124" }]}`)
+		if recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		job, err = restapi.JobRepository.FindById(ctx, job.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.NumNodes != 2 || job.NumHWThreads != 16 || job.NumAcc != 0 {
+			t.Errorf("unexpected job properties: %#v", job)
+		}
+
+		events, err := restapi.JobRepository.GetJobEvents(job.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 3 || events[1].Event != schema.JobEventUpdate || events[1].Actor != "testuser" ||
+			!strings.Contains(events[1].Details, "walltime: 3600 -> 7200") {
+			t.Errorf("unexpected job events: %#v", events)
+		}
+
+		stoppedId := int64(5001)
+		stopped, err := restapi.JobRepository.Find(&stoppedId, &cluster, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if recorder := patch(stopped.ID, `{"walltime": 7200}`); recorder.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected update of stopped job to fail: %d", recorder.Code)
+		}
+	})
+
+	t.Run("ImportJob", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), contextUserKey, contextUserValue)
+		meta := func(jobId int64, state schema.JobState) string {
+			return fmt.Sprintf(`{
+				"jobId": %d, "user": "testuser", "project": "testproj", "cluster": "testcluster",
+				"partition": "default", "numNodes": 1, "numHwthreads": 8, "exclusive": 1, "walltime": 3600,
+				"resources": [{ "hostname": "host123", "hwthreads": [0, 1, 2, 3, 4, 5, 6, 7] }],
+				"tags": [{ "type": "imported", "name": "testtag" }],
+				"jobState": "%s", "startTime": 1730000000, "duration": 600,
+				"statistics": { "load_one": { "unit": { "base": "load" }, "min": 0.1, "avg": 0.2, "max": 0.3 } }
+			}`, jobId, state)
+		}
+		data, err := json.Marshal(testData)
+		if err != nil {
+			t.Fatal(err)
+		}
+		post := func(body io.Reader, contentType string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/jobs/import/", body)
+			req.Header.Set("Content-Type", contentType)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req.WithContext(ctx))
+			return recorder
+		}
+		combined := func(meta string) io.Reader {
+			return strings.NewReader(fmt.Sprintf(`{"meta": %s, "data": %s}`, meta, data))
+		}
+
+		recorder := post(combined(meta(6001, schema.JobStateCompleted)), "application/json")
+		if recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		var res api.ImportJobApiResponse
+		if err := json.NewDecoder(recorder.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+
+		if recorder := post(combined(meta(6001, schema.JobStateCompleted)), "application/json"); recorder.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected import of duplicate job to fail: %d", recorder.Code)
+		}
+		if recorder := post(combined(meta(6002, schema.JobStateRunning)), "application/json"); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected import of running job to fail: %d", recorder.Code)
+		}
+		if recorder := post(strings.NewReader(fmt.Sprintf(`{"meta": %s}`, meta(6002, schema.JobStateCompleted))), "application/json"); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected import without job data to fail: %d", recorder.Code)
+		}
+
+		// Multipart form with gzip compressed job data
+		body := &bytes.Buffer{}
+		mw := multipart.NewWriter(body)
+		part, err := mw.CreateFormFile("meta", "meta.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write([]byte(meta(6002, schema.JobStateFailed)))
+		if part, err = mw.CreateFormFile("data", "data.json.gz"); err != nil {
+			t.Fatal(err)
+		}
+		zw := gzip.NewWriter(part)
+		zw.Write(data)
+		zw.Close()
+		mw.Close()
+		if recorder := post(body, mw.FormDataContentType()); recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+
+		job, err := restapi.JobRepository.FindById(ctx, res.DBID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.JobID != 6001 || job.State != schema.JobStateCompleted || job.MonitoringStatus != schema.MonitoringStatusArchivingSuccessful {
+			t.Errorf("unexpected job properties: %#v", job)
+		}
+		tags, err := restapi.JobRepository.GetTags(contextUserValue, &job.ID)
+		if err != nil || len(tags) != 1 || tags[0].Type != "imported" {
+			t.Errorf("unexpected tags: %#v %v", tags, err)
+		}
+		jobData, err := archive.GetHandle().LoadJobData(job, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := jobData["load_one"]; !ok {
+			t.Errorf("unexpected job data: %#v", jobData)
+		}
+
+		jobId, cluster := int64(6002), "testcluster"
+		if job, err := restapi.JobRepository.Find(&jobId, &cluster, nil); err != nil || job.State != schema.JobStateFailed {
+			t.Errorf("expected imported failed job: %v", err)
+		}
+
+		// The limit applies to the body and to the decompressed data
+		defer func(size int) { config.Keys.ImportMaxSize = size }(config.Keys.ImportMaxSize)
+		config.Keys.ImportMaxSize = 1
+		large := bytes.Repeat([]byte(" "), 2*1024*1024)
+		if recorder := post(bytes.NewReader(large), "application/json"); recorder.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("expected import of large body to fail: %d", recorder.Code)
+		}
+		compressed := &bytes.Buffer{}
+		zw = gzip.NewWriter(compressed)
+		zw.Write(large)
+		zw.Close()
+		if recorder := post(compressed, "application/json"); recorder.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("expected import of large compressed body to fail: %d", recorder.Code)
+		}
+	})
+
+	t.Run("Webhooks", func(t *testing.T) {
+		type received struct {
+			header http.Header
+			body   []byte
+		}
+		deliveries := make(chan received, 10)
+		receiver := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			deliveries <- received{header: r.Header, body: body}
+		}))
+		defer receiver.Close()
+
+		adminUser := &schema.User{Username: "testadmin", Roles: []string{"admin"}, AuthSource: 2}
+		call := func(user *schema.User, method, path, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, path, strings.NewReader(body))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, user)))
+			return recorder
+		}
+
+		hookBody := fmt.Sprintf(`{"name": "test", "url": %q, "events": ["job_started", "job_stopped"]}`, receiver.URL)
+		if recorder := call(contextUserValue, http.MethodPost, "/webhooks/", hookBody); recorder.Code != http.StatusForbidden {
+			t.Fatalf("expected registration by non-admin to fail: %d", recorder.Code)
+		}
+		if recorder := call(adminUser, http.MethodPost, "/webhooks/", `{"name": "invalid", "url": "ftp://example.com", "events": ["job_started"]}`); recorder.Code != http.StatusBadRequest {
+			t.Fatalf("expected registration with invalid url to fail: %d", recorder.Code)
+		}
+		if recorder := call(adminUser, http.MethodPost, "/webhooks/", `{"name": "invalid", "url": "https://example.com", "events": ["job_deleted"]}`); recorder.Code != http.StatusBadRequest {
+			t.Fatalf("expected registration with invalid event to fail: %d", recorder.Code)
+		}
+
+		recorder := call(adminUser, http.MethodPost, "/webhooks/", hookBody)
+		if recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		var hook schema.Webhook
+		if err := json.NewDecoder(recorder.Body).Decode(&hook); err != nil {
+			t.Fatal(err)
+		}
+		if hook.Secret == "" || !hook.Active || hook.CreatedBy != "testadmin" {
+			t.Fatalf("unexpected webhook: %#v", hook)
+		}
+
+		recorder = call(adminUser, http.MethodGet, "/webhooks/", "")
+		var hooks api.GetWebhooksApiResponse
+		if err := json.NewDecoder(recorder.Body).Decode(&hooks); err != nil {
+			t.Fatal(err)
+		}
+		if len(hooks.Webhooks) != 1 || hooks.Webhooks[0].ID != hook.ID || hooks.Webhooks[0].Secret != "" {
+			t.Fatalf("unexpected webhooks: %#v", hooks.Webhooks)
+		}
+
+		startBody := `{
+			"jobId": 7001, "user": "testuser", "project": "testproj", "cluster": "testcluster",
+			"partition": "default", "numNodes": 1, "numHwthreads": 8, "exclusive": 1,
+			"resources": [{ "hostname": "host123", "hwthreads": [0, 1, 2, 3, 4, 5, 6, 7] }],
+			"startTime": 1720000000
+		}`
+		if recorder := call(contextUserValue, http.MethodPost, "/jobs/start_job/", startBody); recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+
+		var delivery received
+		select {
+		case delivery = <-deliveries:
+		case <-time.After(10 * time.Second):
+			t.Fatal("webhook was not called")
+		}
+		if delivery.header.Get("X-CC-Event") != "job_started" ||
+			delivery.header.Get("X-CC-Signature") != "sha256="+webhook.Sign(hook.Secret, delivery.body) {
+			t.Errorf("unexpected headers: %#v", delivery.header)
+		}
+		var payload struct {
+			Event schema.WebhookEvent `json:"event"`
+			Data  struct {
+				Job webhook.Job `json:"job"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(delivery.body, &payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.Event != schema.WebhookEventJobStarted || payload.Data.Job.JobID != 7001 || payload.Data.Job.StartTime != 1720000000 {
+			t.Errorf("unexpected payload: %s", delivery.body)
+		}
+
+		deliveriesPath := fmt.Sprintf("/webhooks/%d/deliveries", hook.ID)
+		var deliveryLog api.GetWebhookDeliveriesApiResponse
+		for i := 0; i < 100; i++ {
+			recorder := call(adminUser, http.MethodGet, deliveriesPath, "")
+			if recorder.Code != http.StatusOK {
+				t.Fatal(recorder.Code, recorder.Body.String())
+			}
+			if err := json.NewDecoder(recorder.Body).Decode(&deliveryLog); err != nil {
+				t.Fatal(err)
+			}
+			if len(deliveryLog.Deliveries) == 1 && deliveryLog.Deliveries[0].Status != schema.WebhookDeliveryPending {
+				break
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		if len(deliveryLog.Deliveries) != 1 || deliveryLog.Deliveries[0].Status != schema.WebhookDeliveryDelivered ||
+			deliveryLog.Deliveries[0].Attempts != 1 || deliveryLog.Deliveries[0].ResponseStatus != http.StatusOK {
+			t.Fatalf("unexpected deliveries: %#v", deliveryLog.Deliveries)
+		}
+
+		path := fmt.Sprintf("/webhooks/%d", hook.ID)
+		if recorder := call(adminUser, http.MethodPatch, path, `{"active": false}`); recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		stopBody := `{"jobId": 7001, "cluster": "testcluster", "startTime": 1720000000, "jobState": "completed", "stopTime": 1720003600}`
+		if recorder := call(contextUserValue, http.MethodPost, "/jobs/stop_job/", stopBody); recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		archiver.WaitForArchiving()
+		select {
+		case delivery = <-deliveries:
+			t.Errorf("inactive webhook was called: %s", delivery.body)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		// Tags of started jobs fire the tag event
+		if recorder := call(adminUser, http.MethodPatch, path, `{"active": true, "events": ["job_tagged"]}`); recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		taggedBody := strings.Replace(strings.Replace(startBody, "7001", "7002", 1), `"startTime"`, `"tags": [{ "type": "hook", "name": "started", "scope": "testuser" }], "startTime"`, 1)
+		if recorder := call(contextUserValue, http.MethodPost, "/jobs/start_job/", taggedBody); recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		select {
+		case delivery = <-deliveries:
+		case <-time.After(10 * time.Second):
+			t.Fatal("webhook was not called")
+		}
+		var taggedPayload struct {
+			Event schema.WebhookEvent `json:"event"`
+			Data  struct {
+				Job webhook.Job `json:"job"`
+				Tag schema.Tag  `json:"tag"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(delivery.body, &taggedPayload); err != nil {
+			t.Fatal(err)
+		}
+		if taggedPayload.Event != schema.WebhookEventJobTagged || taggedPayload.Data.Job.JobID != 7002 ||
+			taggedPayload.Data.Tag.Name != "started" || taggedPayload.Data.Tag.ID == 0 {
+			t.Errorf("unexpected payload: %s", delivery.body)
+		}
+
+		if recorder := call(adminUser, http.MethodGet, deliveriesPath+"?page=0", ""); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected page 0 to fail: %d", recorder.Code)
+		}
+
+		if recorder := call(adminUser, http.MethodDelete, path, ""); recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		if recorder := call(adminUser, http.MethodGet, deliveriesPath, ""); recorder.Code != http.StatusNotFound {
+			t.Errorf("expected deliveries of deleted webhook to be gone: %d", recorder.Code)
+		}
+	})
+
+	t.Run("ApiTokens", func(t *testing.T) {
+		if err := repository.GetUserRepository().AddUser(&schema.User{Username: "tokenuser", Roles: []string{"api"}, AuthSource: -1}); err != nil {
+			t.Fatal(err)
+		}
+
+		adminUser := &schema.User{Username: "testadmin", Roles: []string{"admin"}, AuthSource: 2}
+		call := func(user *schema.User, method, path, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, path, strings.NewReader(body))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), contextUserKey, user)))
+			return recorder
+		}
+
+		tokenBody := `{"name": "adapter", "username": "tokenuser", "scopes": ["job_control", "job_edit"], "clusters": ["testcluster"]}`
+		if recorder := call(contextUserValue, http.MethodPost, "/tokens/", tokenBody); recorder.Code != http.StatusForbidden {
+			t.Fatalf("expected creation of token for others by non-admin to fail: %d", recorder.Code)
+		}
+		if recorder := call(adminUser, http.MethodPost, "/tokens/", `{"name": "invalid", "username": "tokenuser", "scopes": ["everything"]}`); recorder.Code != http.StatusBadRequest {
+			t.Fatalf("expected creation of token with invalid scope to fail: %d", recorder.Code)
+		}
+		if recorder := call(adminUser, http.MethodPost, "/tokens/", `{"name": "invalid", "username": "tokenuser", "scopes": ["read"], "clusters": ["nocluster"]}`); recorder.Code != http.StatusBadRequest {
+			t.Fatalf("expected creation of token for unknown cluster to fail: %d", recorder.Code)
+		}
+
+		recorder := call(adminUser, http.MethodPost, "/tokens/", tokenBody)
+		if recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		var token schema.ApiToken
+		if err := json.NewDecoder(recorder.Body).Decode(&token); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(token.Token, schema.ApiTokenPrefix) || token.Username != "tokenuser" {
+			t.Fatalf("unexpected token: %#v", token)
+		}
+		if recorder := call(adminUser, http.MethodPost, "/tokens/", tokenBody); recorder.Code != http.StatusBadRequest {
+			t.Fatalf("expected creation of token with duplicate name to fail: %d", recorder.Code)
+		}
+
+		// Routes behind the authentication of the REST API
+		authRouter := mux.NewRouter()
+		apiRouter := authRouter.PathPrefix("/api").Subrouter()
+		apiRouter.Use(func(next http.Handler) http.Handler {
+			return auth.GetAuthInstance().AuthApi(next, func(rw http.ResponseWriter, r *http.Request, err error) {
+				rw.WriteHeader(http.StatusUnauthorized)
+			})
+		})
+		restapi.MountApiRoutes(apiRouter)
+		callWithToken := func(rawToken, method, path, body string) int {
+			req := httptest.NewRequest(method, path, strings.NewReader(body))
+			req.Header.Set("Authorization", "Bearer "+rawToken)
+			recorder := httptest.NewRecorder()
+			authRouter.ServeHTTP(recorder, req)
+			return recorder.Code
+		}
+
+		startBody := `{
+			"jobId": 8001, "user": "testuser", "project": "testproj", "cluster": "testcluster",
+			"partition": "default", "numNodes": 1, "numHwthreads": 8, "exclusive": 1,
+			"resources": [{ "hostname": "host123", "hwthreads": [0, 1, 2, 3, 4, 5, 6, 7] }],
+			"startTime": 1730000000
+		}`
+		if code := callWithToken(token.Token, http.MethodPost, "/api/jobs/start_job/", startBody); code != http.StatusCreated {
+			t.Fatalf("expected start of job with token to succeed: %d", code)
+		}
+		stopBody := `{"jobId": 8001, "cluster": "testcluster", "startTime": 1730000000, "jobState": "completed", "stopTime": 1730003600}`
+		if code := callWithToken(token.Token, http.MethodPost, "/api/jobs/stop_job/", stopBody); code != http.StatusOK {
+			t.Fatalf("expected stop of job with token to succeed: %d", code)
+		}
+		archiver.WaitForArchiving()
+
+		if code := callWithToken(token.Token, http.MethodGet, "/api/clusters/", ""); code != http.StatusUnauthorized {
+			t.Errorf("expected request without read scope to fail: %d", code)
+		}
+		if code := callWithToken(token.Token, http.MethodPost, "/api/nodestate/", `{"cluster": "testcluster", "nodes": []}`); code != http.StatusUnauthorized {
+			t.Errorf("expected request without node state scope to fail: %d", code)
+		}
+		if code := callWithToken(token.Token, http.MethodDelete, "/api/jobs/delete_job_before/1", ""); code != http.StatusForbidden {
+			t.Errorf("expected request for all clusters to fail: %d", code)
+		}
+		if code := callWithToken(schema.ApiTokenPrefix+"invalid", http.MethodGet, "/api/clusters/", ""); code != http.StatusUnauthorized {
+			t.Errorf("expected request with invalid token to fail: %d", code)
+		}
+
+		recorder = call(adminUser, http.MethodGet, "/tokens/?user=tokenuser", "")
+		var tokens api.GetApiTokensApiResponse
+		if err := json.NewDecoder(recorder.Body).Decode(&tokens); err != nil {
+			t.Fatal(err)
+		}
+		if len(tokens.Tokens) != 1 || tokens.Tokens[0].ID != token.ID || tokens.Tokens[0].Token != "" || tokens.Tokens[0].LastUsed == 0 {
+			t.Fatalf("unexpected tokens: %#v", tokens.Tokens)
+		}
+		if recorder := call(contextUserValue, http.MethodGet, "/tokens/?user=tokenuser", ""); recorder.Code != http.StatusForbidden {
+			t.Errorf("expected listing tokens of others by non-admin to fail: %d", recorder.Code)
+		}
+
+		path := fmt.Sprintf("/tokens/%d", token.ID)
+		if recorder := call(contextUserValue, http.MethodDelete, path, ""); recorder.Code != http.StatusForbidden {
+			t.Fatalf("expected revocation of token of others by non-admin to fail: %d", recorder.Code)
+		}
+		if recorder := call(adminUser, http.MethodDelete, path, ""); recorder.Code != http.StatusOK {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		if code := callWithToken(token.Token, http.MethodPost, "/api/jobs/start_job/", strings.Replace(startBody, "8001", "8002", 1)); code != http.StatusUnauthorized {
+			t.Errorf("expected request with revoked token to fail: %d", code)
+		}
+
+		// Reads with a token restricted to a cluster only see its jobs
+		recorder = call(adminUser, http.MethodPost, "/tokens/", `{"name": "reader", "username": "tokenuser", "scopes": ["read"], "clusters": ["testcluster"]}`)
+		if recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		if err := json.NewDecoder(recorder.Body).Decode(&token); err != nil {
+			t.Fatal(err)
+		}
+		db := repository.GetConnection().DB
+		var id int64
+		if err := db.QueryRow("SELECT id FROM job WHERE job_id = 8001").Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.Exec("UPDATE job SET cluster = 'othercluster' WHERE id = ?", id); err != nil {
+			t.Fatal(err)
+		}
+
+		if code := callWithToken(token.Token, http.MethodGet, fmt.Sprintf("/api/jobs/%d", id), ""); code != http.StatusUnprocessableEntity {
+			t.Errorf("expected reading a job of another cluster to fail: %d", code)
+		}
+		req := httptest.NewRequest(http.MethodGet, "/api/jobs/?cluster=othercluster", nil)
+		req.Header.Set("Authorization", "Bearer "+token.Token)
+		recorder = httptest.NewRecorder()
+		authRouter.ServeHTTP(recorder, req)
+		var jobs api.GetJobsApiResponse
+		if err := json.NewDecoder(recorder.Body).Decode(&jobs); err != nil {
+			t.Fatal(recorder.Code, err)
+		}
+		if len(jobs.Jobs) != 0 {
+			t.Errorf("expected no jobs of another cluster, got %d", len(jobs.Jobs))
+		}
+		if _, err := db.Exec("UPDATE job SET cluster = 'testcluster' WHERE id = ?", id); err != nil {
+			t.Fatal(err)
+		}
+		if code := callWithToken(token.Token, http.MethodGet, fmt.Sprintf("/api/jobs/%d", id), ""); code != http.StatusOK {
+			t.Errorf("expected reading a job of the cluster to succeed: %d", code)
+		}
+
+		// And only the nodes of the cluster
+		if _, err := repository.GetNodeRepository().UpdateNodeStates([]*schema.Node{
+			{Cluster: "othercluster", Hostname: "other001", NodeState: schema.NodeStateIdle},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if code := callWithToken(token.Token, http.MethodGet, "/api/nodestate/othercluster", ""); code != http.StatusForbidden {
+			t.Errorf("expected reading the nodes of another cluster to fail: %d", code)
+		}
+		if code := callWithToken(token.Token, http.MethodGet, "/api/nodestate/othercluster/other001", ""); code != http.StatusForbidden {
+			t.Errorf("expected reading a node of another cluster to fail: %d", code)
+		}
+		if code := callWithToken(token.Token, http.MethodGet, "/api/nodestate/testcluster/host124", ""); code != http.StatusOK {
+			t.Errorf("expected reading a node of the cluster to succeed: %d", code)
+		}
+		req = httptest.NewRequest(http.MethodGet, "/api/nodestate/", nil)
+		req.Header.Set("Authorization", "Bearer "+token.Token)
+		recorder = httptest.NewRecorder()
+		authRouter.ServeHTTP(recorder, req)
+		var nodes api.GetNodeStatesApiResponse
+		if err := json.NewDecoder(recorder.Body).Decode(&nodes); err != nil {
+			t.Fatal(recorder.Code, err)
+		}
+		if len(nodes.Nodes) == 0 || slices.ContainsFunc(nodes.Nodes, func(n *schema.Node) bool { return n.Cluster != "testcluster" }) {
+			t.Errorf("expected only nodes of the cluster, got %#v", nodes.Nodes)
+		}
+
+		// Tokens created with a restricted token cannot have fewer restrictions
+		expiresAt := time.Now().Add(time.Hour).Unix()
+		recorder = call(adminUser, http.MethodPost, "/tokens/", fmt.Sprintf(`{"name": "restricted", "username": "tokenuser", "scopes": ["all"], "clusters": ["testcluster"], "expiresAt": %d}`, expiresAt))
+		if recorder.Code != http.StatusCreated {
+			t.Fatal(recorder.Code, recorder.Body.String())
+		}
+		if err := json.NewDecoder(recorder.Body).Decode(&token); err != nil {
+			t.Fatal(err)
+		}
+		for name, body := range map[string]string{
+			"all clusters": fmt.Sprintf(`{"name": "escalated", "scopes": ["all"], "expiresAt": %d}`, expiresAt),
+			"no expiry":    `{"name": "escalated", "scopes": ["all"], "clusters": ["testcluster"]}`,
+			"later expiry": fmt.Sprintf(`{"name": "escalated", "scopes": ["all"], "clusters": ["testcluster"], "expiresAt": %d}`, expiresAt+3600),
+		} {
+			if code := callWithToken(token.Token, http.MethodPost, "/api/tokens/", body); code != http.StatusForbidden {
+				t.Errorf("%s: expected escalation from a restricted token to fail: %d", name, code)
+			}
+		}
+		body := fmt.Sprintf(`{"name": "narrowed", "scopes": ["read"], "clusters": ["testcluster"], "expiresAt": %d}`, expiresAt)
+		if code := callWithToken(token.Token, http.MethodPost, "/api/tokens/", body); code != http.StatusCreated {
+			t.Errorf("expected creation of a token with the same restrictions to succeed: %d", code)
+		}
+
+		// A token without the scope 'all' cannot create tokens at all
+		recorder = call(adminUser, http.MethodPost, "/tokens/", `{"name": "jobs", "username": "tokenuser", "scopes": ["job_control"]}`)
+		if err := json.NewDecoder(recorder.Body).Decode(&token); err != nil {
+			t.Fatal(err)
+		}
+		if code := callWithToken(token.Token, http.MethodPost, "/api/tokens/", `{"name": "escalated", "scopes": ["all"]}`); code != http.StatusUnauthorized {
+			t.Errorf("expected creation of a token without the scope 'all' to fail: %d", code)
+		}
+	})
 }
@@ -0,0 +1,256 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	"github.com/gorilla/mux"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Maximum length of the payload summary of an audit log entry
+const auditPayloadLength = 1024
+
+// Request bodies larger than this are not summarized in the audit log
+const auditMaxBodySize = 1 << 20
+
+type auditContextKey string
+
+const auditSourceIPKey auditContextKey = "auditSourceIP"
+
+// Source address of a request, honoring reverse proxy headers
+func sourceIP(r *http.Request) string {
+	IPAddress := r.Header.Get("X-Real-Ip")
+	if IPAddress == "" {
+		IPAddress = r.Header.Get("X-Forwarded-For")
+	}
+	if IPAddress == "" {
+		IPAddress = r.RemoteAddr
+	}
+
+	if strings.Contains(IPAddress, ":") {
+		IPAddress = strings.Split(IPAddress, ":")[0]
+	}
+
+	return IPAddress
+}
+
+func isSecret(key string) bool {
+	key = strings.ToLower(key)
+	return strings.Contains(key, "password") || strings.Contains(key, "secret") || strings.Contains(key, "token")
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
+
+// Summarize form values, with secrets redacted
+func summarizeForm(values map[string][]string) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := strings.Join(values[k], ",")
+		if isSecret(k) {
+			v = "***"
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	return truncate(strings.Join(parts, "&"), auditPayloadLength)
+}
+
+// Redact the secrets in all objects nested in a decoded JSON value
+func redactSecrets(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if isSecret(k) {
+				v[k] = "***"
+			} else {
+				v[k] = redactSecrets(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactSecrets(e)
+		}
+	}
+	return value
+}
+
+// Summarize a JSON payload, with the secrets of all objects redacted
+func summarizeJSON(payload []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(payload, &value); err == nil {
+		if redacted, err := json.Marshal(redactSecrets(value)); err == nil {
+			payload = redacted
+		}
+	}
+
+	return truncate(string(payload), auditPayloadLength)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// Summary of the request payload. At most auditMaxBodySize bytes are read,
+// the request body is restored for the handler.
+func summarizePayload(r *http.Request) string {
+	contentType := r.Header.Get("Content-Type")
+	if strings.HasPrefix(contentType, "multipart/form-data") {
+		// Large forms are uploads, e.g. of jobs to import
+		if r.ContentLength < 0 || r.ContentLength > auditMaxBodySize {
+			return "(large form not recorded)"
+		}
+	}
+	if strings.HasPrefix(contentType, "multipart/form-data") || strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		// The parsed form is kept in the request and used by FormValue
+		if err := r.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return ""
+		}
+		return summarizeForm(r.Form)
+	}
+
+	if r.Body == nil {
+		return ""
+	}
+	head, err := io.ReadAll(io.LimitReader(r.Body, auditMaxBodySize+1))
+	r.Body = readCloser{io.MultiReader(bytes.NewReader(head), r.Body), r.Body}
+	if err != nil {
+		return ""
+	}
+
+	// Compressed or truncated bodies can not be redacted
+	if len(head) >= 2 && head[0] == 0x1f && head[1] == 0x8b {
+		return "(compressed body not recorded)"
+	}
+	if len(head) > auditMaxBodySize {
+		return "(large body not recorded)"
+	}
+	return summarizeJSON(head)
+}
+
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *auditResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// auditRequests records the mutating requests in the audit log. It has to
+// run after the authentication middleware.
+func auditRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		action := r.URL.Path
+		if route := mux.CurrentRoute(r); route != nil {
+			if tpl, err := route.GetPathTemplate(); err == nil {
+				action = tpl
+			}
+		}
+		entry := &schema.AuditEntry{
+			SourceIP: sourceIP(r),
+			Action:   fmt.Sprintf("%s %s", r.Method, action),
+			Target:   r.URL.RequestURI(),
+			Payload:  summarizePayload(r),
+		}
+
+		aw := &auditResponseWriter{ResponseWriter: rw, status: http.StatusOK}
+		next.ServeHTTP(aw, r)
+
+		user := repository.GetUserFromContext(r.Context())
+		if user != nil {
+			entry.Username = user.Username
+		}
+		entry.AuthMethod = repository.AuthMethod(user)
+		entry.Status = aw.status
+		repository.GetAuditRepository().Add(entry)
+	})
+}
+
+// AuditContext stores the source address of GraphQL requests for the audit
+// log entries of mutations.
+func AuditContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), auditSourceIPKey, sourceIP(r))
+		next.ServeHTTP(rw, r.WithContext(ctx))
+	})
+}
+
+// AuditMutations records all GraphQL mutations in the audit log. To be used
+// with `AroundOperations` of the GraphQL server.
+func AuditMutations(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	if oc == nil || oc.Operation == nil || oc.Operation.Operation != ast.Mutation {
+		return next(ctx)
+	}
+
+	fields := make([]string, 0, len(oc.Operation.SelectionSet))
+	for _, sel := range oc.Operation.SelectionSet {
+		if field, ok := sel.(*ast.Field); ok {
+			fields = append(fields, field.Name)
+		}
+	}
+
+	entry := &schema.AuditEntry{
+		Action: fmt.Sprintf("mutation %s", strings.Join(fields, ",")),
+		Target: "/query",
+	}
+	if ip, ok := ctx.Value(auditSourceIPKey).(string); ok {
+		entry.SourceIP = ip
+	}
+	if len(oc.Variables) != 0 {
+		if vars, err := json.Marshal(oc.Variables); err == nil {
+			entry.Payload = summarizeJSON(vars)
+		}
+	} else {
+		entry.Payload = truncate(oc.RawQuery, auditPayloadLength)
+	}
+
+	user := repository.GetUserFromContext(ctx)
+	if user != nil {
+		entry.Username = user.Username
+	}
+	entry.AuthMethod = repository.AuthMethod(user)
+
+	responseHandler := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		response := responseHandler(ctx)
+		entry.Status = http.StatusOK
+		if response != nil && len(response.Errors) != 0 {
+			entry.Status = http.StatusUnprocessableEntity
+		}
+		repository.GetAuditRepository().Add(entry)
+		return response
+	}
+}
@@ -23,6 +23,104 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/audit/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the audit log of all mutating REST and GraphQL requests, newest first. Filters can be applied using query parameters.\nOnly accessible by admins. Use format=csv to export the (filtered) log.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Lists the audit log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exact username",
+                        "name": "user",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Substring of the action, e.g. 'delete_job'",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Substring of the request target",
+                        "name": "target",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Syntax: '$from-$to', as unix epoch timestamps in seconds",
+                        "name": "time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (Default: 100, -1 for all)",
+                        "name": "items-per-page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number (Default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "Response format (Default: json)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Audit log entries and page info",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetAuditLogApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/clusters/": {
             "get": {
                 "security": [
@@ -80,6 +178,89 @@ const docTemplate = `{
                 }
             }
         },
+        "/clusters/utilization/{cluster}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the nodes, cores and accelerators allocated by jobs per time bucket, averaged over the bucket.\nRunning jobs count until now. The utilization can be restricted to a subcluster or partition.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cluster query"
+                ],
+                "summary": "Get cluster utilization over time",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cluster name",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subcluster name",
+                        "name": "subcluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Partition name",
+                        "name": "partition",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Syntax: '$from-$to', as unix epoch timestamps in seconds",
+                        "name": "time",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Bucket size in seconds (Default: 3600)",
+                        "name": "bucket-size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Allocated resources per time bucket",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetClusterUtilizationApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/jobs/": {
             "get": {
                 "security": [
@@ -134,6 +315,12 @@ const docTemplate = `{
                         "name": "page",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as nextCursor or prevCursor, empty for the first jobs. Replaces page.",
+                        "name": "cursor",
+                        "in": "query"
+                    },
                     {
                         "type": "boolean",
                         "description": "Include metadata (e.g. jobScript) in response",
@@ -182,7 +369,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Job to delete is specified by request body. All fields are required in this case.",
+                "description": "Job to delete is specified by request body. All fields are required in this case.\nThe job is marked as deleted and purged after the grace period. Running jobs cannot be deleted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -257,7 +444,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Job to remove is specified by database ID. This will not remove the job from the job archive.",
+                "description": "Job to remove is specified by database ID. The job is marked as deleted and hidden from all queries.\nRunning jobs cannot be deleted.\nAdmins can restore it until it is purged from the database and the job archive after the grace period.",
                 "produces": [
                     "application/json"
                 ],
@@ -327,7 +514,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove all jobs with start time before timestamp. The jobs will not be removed from the job archive.",
+                "description": "Remove all jobs with start time before timestamp. The jobs are marked as deleted and purged\nfrom the database and the job archive after the grace period. Running jobs are skipped.",
                 "produces": [
                     "application/json"
                 ],
@@ -390,6 +577,49 @@ const docTemplate = `{
                 }
             }
         },
+        "/jobs/deleted/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all jobs marked as deleted that are not purged yet. Only accessible by admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job remove"
+                ],
+                "summary": "Lists deleted jobs",
+                "responses": {
+                    "200": {
+                        "description": "Deleted jobs",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetDeletedJobsApiResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/jobs/edit_meta/{id}": {
             "post": {
                 "security": [
@@ -460,40 +690,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/jobs/start_job/": {
-            "post": {
+        "/jobs/events/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Job specified in request body will be saved to database as \"running\" with new DB ID.\nJob specifications follow the 'JobMeta' scheme, API will fail to execute if requirements are not met.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Job to get is specified by database ID\nReturns all recorded events of the job: start, stop, archiving, metadata edits and tag changes.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Job add and modify"
+                    "Job query"
                 ],
-                "summary": "Adds a new job as \"running\"",
+                "summary": "Get the lifecycle of a job",
                 "parameters": [
                     {
-                        "description": "Job to add",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.JobMeta"
-                        }
+                        "type": "integer",
+                        "description": "Database ID of Job",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Job added successfully",
+                    "200": {
+                        "description": "Job events",
                         "schema": {
-                            "$ref": "#/definitions/api.StartJobApiResponse"
+                            "$ref": "#/definitions/api.GetJobEventsApiResponse"
                         }
                     },
                     "400": {
@@ -514,8 +739,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity: The combination of jobId, clusterId and startTime does already exist",
+                    "404": {
+                        "description": "Resource not found",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
@@ -529,37 +754,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/jobs/stop_job/": {
+        "/jobs/export/": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Job to stop is specified by request body. All fields are required in this case.\nReturns full job resource information according to 'JobMeta' scheme.",
-                "produces": [
+                "description": "Streams all jobs matching the filters as CSV, NDJSON or Parquet, not only a single page.\nColumns can be selected by name, footprint values as 'footprint.\u003cmetric\u003e' and metadata as 'meta.\u003ckey\u003e'.\nOnly jobs visible to the user are exported.",
+                "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.apache.parquet"
+                ],
                 "tags": [
-                    "Job add and modify"
+                    "Job query"
                 ],
-                "summary": "Marks job as completed and triggers archiving",
+                "summary": "Exports jobs",
                 "parameters": [
                     {
-                        "description": "All fields required",
+                        "description": "Filters, format and columns",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.StopJobApiRequest"
+                            "$ref": "#/definitions/api.ExportJobsApiRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "Exported jobs",
                         "schema": {
-                            "$ref": "#/definitions/schema.JobMeta"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -580,18 +810,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Resource not found",
-                        "schema": {
-                            "$ref": "#/definitions/api.ErrorResponse"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity: finding job failed: sql: no rows in result set",
-                        "schema": {
-                            "$ref": "#/definitions/api.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -601,16 +819,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/jobs/tag_job/{id}": {
+        "/jobs/import/": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Adds tag(s) to a job specified by DB ID. Name and Type of Tag(s) can be chosen freely.\nTag Scope for frontend visibility will default to \"global\" if none entered, other options: \"admin\" or specific username.\nIf tagged job is already finished: Tag will be written directly to respective archive files.",
+                "description": "Imports a job with its metric data into the job archive and the database, like the -import-job command line flag.\nThe meta.json and the data.json of the job are either sent as parts \"meta\" and \"data\" of a multipart form or combined as JSON body.\nBoth, the body and the parts, can be gzip compressed. They are validated against the JSON schemas if validation is enabled.\nThe body and the decompressed parts are limited by the 'import-max-size' configuration.",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
@@ -618,33 +837,23 @@ const docTemplate = `{
                 "tags": [
                     "Job add and modify"
                 ],
-                "summary": "Adds one or more tags to a job",
+                "summary": "Imports a finished job",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Job Database ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Array of tag-objects to add",
+                        "description": "Job to import",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/api.ApiTag"
-                            }
+                            "$ref": "#/definitions/api.ImportJobApiRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Updated job resource",
+                    "201": {
+                        "description": "Job imported successfully",
                         "schema": {
-                            "$ref": "#/definitions/schema.Job"
+                            "$ref": "#/definitions/api.ImportJobApiResponse"
                         }
                     },
                     "400": {
@@ -659,8 +868,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Job or tag does not exist",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large: The job exceeds the import limit",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity: The combination of jobId, clusterId and startTime does already exist",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
@@ -674,21 +895,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/jobs/{id}": {
-            "get": {
+        "/jobs/restore_job/{id}": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Job to get is specified by database ID\nReturns full job resource information according to 'JobMeta' scheme and all metrics according to 'JobData'.",
+                "description": "Job to restore is specified by database ID. Jobs can be restored until they are purged after the grace period.\nJobs cannot be restored while a job with the same jobId and cluster that started within a day exists.\nOnly accessible by admins.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Job query"
+                    "Job remove"
                 ],
-                "summary": "Get job meta and optional all metric data",
+                "summary": "Restore a deleted job",
                 "parameters": [
                     {
                         "type": "integer",
@@ -696,19 +917,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Include all available metrics",
-                        "name": "all-metrics",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Job resource",
+                        "description": "Success message",
                         "schema": {
-                            "$ref": "#/definitions/api.GetJobApiResponse"
+                            "$ref": "#/definitions/api.DefaultJobApiResponse"
                         }
                     },
                     "400": {
@@ -729,14 +944,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Resource not found",
-                        "schema": {
-                            "$ref": "#/definitions/api.ErrorResponse"
-                        }
-                    },
                     "422": {
-                        "description": "Unprocessable Entity: finding job failed: sql: no rows in result set",
+                        "description": "Unprocessable Entity: restoring job failed: sql: no rows in result set",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
@@ -748,14 +957,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/jobs/start_job/": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Job to get is specified by database ID\nReturns full job resource information according to 'JobMeta' scheme and all metrics according to 'JobData'.",
+                "description": "Job specified in request body will be saved to database as \"running\" with new DB ID.\nJob specifications follow the 'JobMeta' scheme, API will fail to execute if requirements are not met.",
                 "consumes": [
                     "application/json"
                 ],
@@ -763,35 +974,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job query"
+                    "Job add and modify"
                 ],
-                "summary": "Get job meta and configurable metric data",
+                "summary": "Adds a new job as \"running\"",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Database ID of Job",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Array of metric names",
+                        "description": "Job to add",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/schema.JobMeta"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Job resource",
+                    "201": {
+                        "description": "Job added successfully",
                         "schema": {
-                            "$ref": "#/definitions/api.GetJobApiResponse"
+                            "$ref": "#/definitions/api.StartJobApiResponse"
                         }
                     },
                     "400": {
@@ -812,14 +1013,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Resource not found",
-                        "schema": {
-                            "$ref": "#/definitions/api.ErrorResponse"
-                        }
-                    },
                     "422": {
-                        "description": "Unprocessable Entity: finding job failed: sql: no rows in result set",
+                        "description": "Unprocessable Entity: The combination of jobId, clusterId and startTime does already exist",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
@@ -833,555 +1028,2632 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/{id}": {
+        "/jobs/start_jobs/": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Modifies user defined by username (id) in one of four possible ways.\nIf more than one formValue is set then only the highest priority field is used.\nOnly accessible from IPs registered with apiAllowedIPs configuration option.",
+                "description": "Jobs specified in the request body array will be saved to the database as \"running\" in a single transaction.\nEach job is checked like in /jobs/start_job/, a failed check only rejects that job. The response holds one result per job in request order.\nAt most 1000 jobs can be started with one request.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Job add and modify"
                 ],
-                "summary": "Updates an existing user",
+                "summary": "Adds a batch of new jobs as \"running\"",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Database ID of User",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "admin",
-                            "support",
-                            "manager",
-                            "user",
-                            "api"
-                        ],
-                        "type": "string",
-                        "description": "Priority 1: Role to add",
-                        "name": "add-role",
-                        "in": "formData"
-                    },
-                    {
-                        "enum": [
-                            "admin",
-                            "support",
-                            "manager",
-                            "user",
-                            "api"
-                        ],
-                        "type": "string",
-                        "description": "Priority 2: Role to remove",
-                        "name": "remove-role",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Priority 3: Project to add",
-                        "name": "add-project",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Priority 4: Project to remove",
-                        "name": "remove-project",
-                        "in": "formData"
+                        "description": "Jobs to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schema.JobMeta"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success Response Message",
+                        "description": "Results per job",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.BulkJobApiResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity: The user could not be updated",
-                        "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/users/": {
-            "get": {
+        "/jobs/stop_job/": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Returns a JSON-encoded list of users.\nRequired query-parameter defines if all users or only users with additional special roles are returned.\nOnly accessible from IPs registered with apiAllowedIPs configuration option.",
+                "description": "Job to stop is specified by request body. All fields are required in this case.\nReturns full job resource information according to 'JobMeta' scheme.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Job add and modify"
                 ],
-                "summary": "Returns a list of users",
+                "summary": "Marks job as completed and triggers archiving",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "If returned list should contain all users or only users with additional special roles",
-                        "name": "not-just-user",
-                        "in": "query",
-                        "required": true
+                        "description": "All fields required",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.StopJobApiRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of users returned successfully",
+                        "description": "Success message",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/api.ApiReturnedUser"
-                            }
+                            "$ref": "#/definitions/schema.JobMeta"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity: finding job failed: sql: no rows in result set",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/jobs/stop_jobs/": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "User specified in form data will be saved to database.\nOnly accessible from IPs registered with apiAllowedIPs configuration option.",
+                "description": "Jobs to stop are specified like in /jobs/stop_job/ and updated in a single transaction.\nA job that cannot be found or stopped only rejects that request. The response holds one result per request in request order.\nAt most 1000 jobs can be stopped with one request.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Job add and modify"
                 ],
-                "summary": "Adds a new user",
+                "summary": "Marks a batch of jobs as completed and triggers archiving",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Unique user ID",
-                        "name": "username",
-                        "in": "formData",
-                        "required": true
+                        "description": "Jobs to stop",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.StopJobApiRequest"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Results per job",
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkJobApiResponse"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "User password",
-                        "name": "password",
-                        "in": "formData",
-                        "required": true
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
                     },
-                    {
-                        "enum": [
-                            "admin",
-                            "support",
-                            "manager",
-                            "user",
-                            "api"
-                        ],
-                        "type": "string",
-                        "description": "User role",
-                        "name": "role",
-                        "in": "formData",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/tag_job/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds tag(s) to a job specified by DB ID. Name and Type of Tag(s) can be chosen freely.\nTag Scope for frontend visibility will default to \"global\" if none entered, other options: \"admin\" or specific username.\nIf tagged job is already finished: Tag will be written directly to respective archive files.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job add and modify"
+                ],
+                "summary": "Adds one or more tags to a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job Database ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Managed project, required for new manager role user",
-                        "name": "project",
-                        "in": "formData"
+                        "description": "Array of tag-objects to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ApiTag"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated job resource",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Job"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Job or tag does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/update_job/{id}": {
+            "patch": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Users name",
-                        "name": "name",
-                        "in": "formData"
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Changes the walltime, the partition or the resources of a running job, e.g. for walltime extensions or resized jobs.\nFields missing in the request are not changed. New resources replace the node list, the numbers of nodes, hwthreads and accelerators are recomputed from them.\nIf the resources only list hostnames, exclusive jobs get all hwthreads and accelerators of their nodes and shared jobs keep their numbers.\nThe change is recorded as job event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job add and modify"
+                ],
+                "summary": "Update a running job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job Database ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Users email",
-                        "name": "email",
-                        "in": "formData"
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.UpdateJobApiRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success Response",
+                        "description": "Updated job resource",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/schema.Job"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Job does not exist",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "422": {
-                        "description": "Unprocessable Entity: creating user failed",
+                        "description": "Unprocessable Entity: job is not running",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/jobs/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "User defined by username in form data will be deleted from database.\nOnly accessible from IPs registered with apiAllowedIPs configuration option.",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Job to get is specified by database ID\nReturns full job resource information according to 'JobMeta' scheme and all metrics according to 'JobData'.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Job query"
                 ],
-                "summary": "Deletes a user",
+                "summary": "Get job meta and optional all metric data",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID to delete",
-                        "name": "username",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Database ID of Job",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include all available metrics",
+                        "name": "all-metrics",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User deleted successfully"
+                        "description": "Job resource",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetJobApiResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "422": {
-                        "description": "Unprocessable Entity: deleting user failed",
+                        "description": "Unprocessable Entity: finding job failed: sql: no rows in result set",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "api.ApiReturnedUser": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "projects": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "roles": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
                     }
+                ],
+                "description": "Job to get is specified by database ID\nReturns full job resource information according to 'JobMeta' scheme and all metrics according to 'JobData'.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job query"
+                ],
+                "summary": "Get job meta and configurable metric data",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Database ID of Job",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Array of metric names",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Job resource",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetJobApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity: finding job failed: sql: no rows in result set",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodestate/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the scheduler state, health state, health info and hardware inventory of all known nodes of a cluster.\nWithout a cluster, the nodes of all clusters the API token is valid for are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Lists the states of all nodes of a cluster",
+                "parameters": [
+                    {
+                        "enum": [
+                            "allocated",
+                            "reserved",
+                            "idle",
+                            "mixed",
+                            "down",
+                            "drain",
+                            "maintenance",
+                            "unknown"
+                        ],
+                        "type": "string",
+                        "description": "Node State",
+                        "name": "state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Array of nodes",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetNodeStatesApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Sets scheduler state, health state, health info and hardware inventory of the listed nodes.\nUnknown nodes are added. Changes of the node state, health state or reason are recorded in the node state history.\nAll nodes are updated or none. Updates with a timestamp older than the stored state of a node are skipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Updates the state of nodes",
+                "parameters": [
+                    {
+                        "description": "Cluster and list of node states",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.UpdateNodeStatesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/api.DefaultJobApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodestate/{cluster}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the scheduler state, health state, health info and hardware inventory of all known nodes of a cluster.\nWithout a cluster, the nodes of all clusters the API token is valid for are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Lists the states of all nodes of a cluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cluster name",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "allocated",
+                            "reserved",
+                            "idle",
+                            "mixed",
+                            "down",
+                            "drain",
+                            "maintenance",
+                            "unknown"
+                        ],
+                        "type": "string",
+                        "description": "Node State",
+                        "name": "state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Array of nodes",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetNodeStatesApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodestate/{cluster}/{host}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the scheduler state, health state, health info and hardware inventory of a node.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Get the state of a node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cluster name",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hostname",
+                        "name": "host",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Node",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Node"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Node not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notice/": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Modifies the content of notice.txt, shown as notice box on the homepage.\nIf more than one formValue is set then only the highest priority field is used.\nOnly accessible from IPs registered with apiAllowedIPs configuration option.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Updates or empties the notice box content",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Priority 1: New content to display",
+                        "name": "new-content",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success Response Message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity: The user could not be updated",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the API tokens of the requesting user. Admins get the tokens of all users or, with the user parameter, of one user.\nThe tokens themselves are only returned on creation.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Token"
+                ],
+                "summary": "Lists API tokens",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "user",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Array of tokens",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetApiTokensApiResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates a named API token for the REST API. The token has the roles of its user at the time of each request,\nthe scopes and clusters restrict it further: 'read' allows requests that do not change anything,\n'job_control' starting, stopping and updating jobs, 'job_edit' tagging, editing, importing, deleting and restoring jobs,\n'node_state' updating node and machine states and 'all' everything the user is allowed to do.\nThe cluster restriction applies to requests changing jobs and nodes and limits the jobs the token can read.\nThe token is only returned by this request, it is sent like a JWT. Only admins can create tokens for others.\nA request authenticated by an API token can only create tokens with a subset of its scopes and clusters that expire no later.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Token"
+                ],
+                "summary": "Creates an API token",
+                "parameters": [
+                    {
+                        "description": "Token to create",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CreateApiTokenApiRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created token including the token itself",
+                        "schema": {
+                            "$ref": "#/definitions/schema.ApiToken"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity: user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes an API token of the requesting user, admins can revoke all tokens.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Token"
+                ],
+                "summary": "Revokes an API token",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Token ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/api.DefaultJobApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Token not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Modifies user defined by username (id) in one of four possible ways.\nIf more than one formValue is set then only the highest priority field is used.\nOnly accessible from IPs registered with apiAllowedIPs configuration option.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Updates an existing user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Database ID of User",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "admin",
+                            "support",
+                            "manager",
+                            "user",
+                            "api"
+                        ],
+                        "type": "string",
+                        "description": "Priority 1: Role to add",
+                        "name": "add-role",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "admin",
+                            "support",
+                            "manager",
+                            "user",
+                            "api"
+                        ],
+                        "type": "string",
+                        "description": "Priority 2: Role to remove",
+                        "name": "remove-role",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Priority 3: Project to add",
+                        "name": "add-project",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Priority 4: Project to remove",
+                        "name": "remove-project",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success Response Message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity: The user could not be updated",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns a JSON-encoded list of users.\nRequired query-parameter defines if all users or only users with additional special roles are returned.\nOnly accessible from IPs registered with apiAllowedIPs configuration option.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Returns a list of users",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "If returned list should contain all users or only users with additional special roles",
+                        "name": "not-just-user",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of users returned successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ApiReturnedUser"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "User specified in form data will be saved to database.\nOnly accessible from IPs registered with apiAllowedIPs configuration option.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Adds a new user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique user ID",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "admin",
+                            "support",
+                            "manager",
+                            "user",
+                            "api"
+                        ],
+                        "type": "string",
+                        "description": "User role",
+                        "name": "role",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Managed project, required for new manager role user",
+                        "name": "project",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Users name",
+                        "name": "name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Users email",
+                        "name": "email",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success Response",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity: creating user failed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "User defined by username in form data will be deleted from database.\nOnly accessible from IPs registered with apiAllowedIPs configuration option.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Deletes a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID to delete",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity: deleting user failed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all webhooks ordered by name. Secrets are not returned.\nOnly accessible by admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Lists all webhooks",
+                "responses": {
+                    "200": {
+                        "description": "Array of webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetWebhooksApiResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Registers an endpoint that is notified about the given events by a POST request with a JSON payload.\nThe payload is signed with HMAC-SHA256, the signature is sent in the header 'X-CC-Signature: sha256=\u003chex\u003e'.\nIf no secret is given, one is generated. The secret is only returned by this request.\nFailed deliveries are retried with exponential backoff. Only accessible by admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Registers a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook to register, name, url and events are required",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.WebhookApiRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Registered webhook including its secret",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Updates the given fields of a webhook, fields that are not set are left unchanged.\nAn empty secret generates a new one, which is then returned. Only accessible by admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Updates a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.WebhookApiRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated webhook",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes a webhook together with its delivery log. Pending deliveries are dropped.\nOnly accessible by admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Removes a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/api.DefaultJobApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the delivery log of a webhook, newest first. Pending deliveries are included.\nFinished deliveries are kept for 30 days. Only accessible by admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Lists the deliveries to a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (Default: 100, -1 for all)",
+                        "name": "items-per-page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number (Default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deliveries and page info",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetWebhookDeliveriesApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.ApiReturnedUser": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ApiTag": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Tag Name",
+                    "type": "string",
+                    "example": "Testjob"
+                },
+                "scope": {
+                    "description": "Tag Scope for Frontend Display",
+                    "type": "string",
+                    "example": "global"
+                },
+                "type": {
+                    "description": "Tag Type",
+                    "type": "string",
+                    "example": "Debug"
+                }
+            }
+        },
+        "api.BulkJobApiResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "description": "Results in the order of the requests",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BulkJobApiResult"
+                    }
+                }
+            }
+        },
+        "api.BulkJobApiResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Error message if the request failed",
+                    "type": "string",
+                    "example": "Error"
+                },
+                "id": {
+                    "description": "Database ID of the started or stopped job",
+                    "type": "integer",
+                    "example": 123000
+                },
+                "status": {
+                    "description": "HTTP status of the single request",
+                    "type": "integer",
+                    "example": 201
+                }
+            }
+        },
+        "api.CreateApiTokenApiRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "clusters": {
+                    "description": "Clusters to restrict the token to (Default: all clusters)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "fritz"
+                    ]
+                },
+                "expiresAt": {
+                    "description": "Unix timestamp (Default: 0, the token does not expire)",
+                    "type": "integer",
+                    "example": 1681259812
+                },
+                "name": {
+                    "type": "string",
+                    "example": "slurm-adapter"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ApiTokenScope"
+                    },
+                    "example": [
+                        "job_control"
+                    ]
+                },
+                "username": {
+                    "description": "Owner of the token (Default: the requesting user), only admins can create tokens for others",
+                    "type": "string",
+                    "example": "abcd100h"
+                }
+            }
+        },
+        "api.DefaultJobApiResponse": {
+            "type": "object",
+            "properties": {
+                "msg": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.DeleteJobApiRequest": {
+            "type": "object",
+            "required": [
+                "jobId"
+            ],
+            "properties": {
+                "cluster": {
+                    "description": "Cluster of job",
+                    "type": "string",
+                    "example": "fritz"
+                },
+                "jobId": {
+                    "description": "Cluster Job ID of job",
+                    "type": "integer",
+                    "example": 123000
+                },
+                "startTime": {
+                    "description": "Start Time of job as epoch",
+                    "type": "integer",
+                    "example": 1649723812
+                }
+            }
+        },
+        "api.DeleteJobApiResponse": {
+            "type": "object",
+            "properties": {
+                "msg": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.EditMetaRequest": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "example": "jobScript"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "bash script"
+                }
+            }
+        },
+        "api.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Error Message",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Statustext of Errorcode",
+                    "type": "string"
+                }
+            }
+        },
+        "api.ExportJobsApiRequest": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "description": "Column names, 'footprint.\u003cmetric\u003e' or 'meta.\u003ckey\u003e'",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "jobId",
+                        "user"
+                    ]
+                },
+                "filter": {
+                    "description": "Job filters as for the GraphQL jobs query",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.JobFilter"
+                    }
+                },
+                "format": {
+                    "description": "csv, ndjson or parquet (Default: csv)",
+                    "type": "string",
+                    "example": "csv"
+                },
+                "order": {
+                    "description": "Sort order (Default: descending startTime)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.OrderByInput"
+                        }
+                    ]
+                }
+            }
+        },
+        "api.GetApiTokensApiResponse": {
+            "type": "object",
+            "properties": {
+                "tokens": {
+                    "description": "Array of tokens ordered by user and name, without the tokens themselves",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ApiToken"
+                    }
+                }
+            }
+        },
+        "api.GetAuditLogApiResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "description": "Array of audit log entries, newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.AuditEntry"
+                    }
+                },
+                "items": {
+                    "description": "Number of entries per page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "Page id returned",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.GetClusterUtilizationApiResponse": {
+            "type": "object",
+            "properties": {
+                "utilization": {
+                    "description": "Allocated resources per time bucket",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.UtilizationPoint"
+                    }
+                }
+            }
+        },
+        "api.GetClustersApiResponse": {
+            "type": "object",
+            "properties": {
+                "clusters": {
+                    "description": "Array of clusters",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.Cluster"
+                    }
+                }
+            }
+        },
+        "api.GetDeletedJobsApiResponse": {
+            "type": "object",
+            "properties": {
+                "jobs": {
+                    "description": "Deleted jobs, most recently deleted first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repository.DeletedJob"
+                    }
+                }
+            }
+        },
+        "api.GetJobApiResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.JobMetricWithName"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/schema.Job"
+                }
+            }
+        },
+        "api.GetJobEventsApiResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "description": "Array of job events ordered by time",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.JobEvent"
+                    }
+                }
+            }
+        },
+        "api.GetJobsApiResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Number of jobs returned",
+                    "type": "integer"
+                },
+                "jobs": {
+                    "description": "Array of jobs",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.JobMeta"
+                    }
+                },
+                "nextCursor": {
+                    "description": "Cursor of the next jobs (cursor pagination only)",
+                    "type": "string"
+                },
+                "page": {
+                    "description": "Page id returned",
+                    "type": "integer"
+                },
+                "prevCursor": {
+                    "description": "Cursor of the previous jobs (cursor pagination only)",
+                    "type": "string"
+                }
+            }
+        },
+        "api.GetNodeStatesApiResponse": {
+            "type": "object",
+            "properties": {
+                "nodes": {
+                    "description": "Array of nodes",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.Node"
+                    }
+                }
+            }
+        },
+        "api.GetWebhookDeliveriesApiResponse": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "description": "Array of deliveries, newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.WebhookDelivery"
+                    }
+                },
+                "items": {
+                    "description": "Number of deliveries per page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "Page id returned",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.GetWebhooksApiResponse": {
+            "type": "object",
+            "properties": {
+                "webhooks": {
+                    "description": "Array of webhooks ordered by name, without secrets",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.Webhook"
+                    }
+                }
+            }
+        },
+        "api.ImportJobApiRequest": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Content of the data.json of the job",
+                    "type": "object"
+                },
+                "meta": {
+                    "description": "Content of the meta.json of the job",
+                    "type": "object"
+                }
+            }
+        },
+        "api.ImportJobApiResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Database ID of the imported job",
+                    "type": "integer",
+                    "example": 123000
+                },
+                "msg": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "api.JobMetricWithName": {
+            "type": "object",
+            "properties": {
+                "metric": {
+                    "$ref": "#/definitions/schema.JobMetric"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scope": {
+                    "$ref": "#/definitions/schema.MetricScope"
+                }
+            }
+        },
+        "api.NodeStateApiPayload": {
+            "type": "object",
+            "required": [
+                "hostname",
+                "nodeState"
+            ],
+            "properties": {
+                "healthInfo": {
+                    "description": "Replaces the stored health info if set",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "healthState": {
+                    "enum": [
+                        "full",
+                        "partial",
+                        "failed",
+                        "unknown"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.HealthState"
+                        }
+                    ],
+                    "example": "partial"
+                },
+                "hostname": {
+                    "type": "string",
+                    "example": "f0101"
+                },
+                "inventory": {
+                    "description": "Replaces the stored hardware inventory if set",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "nodeState": {
+                    "enum": [
+                        "allocated",
+                        "reserved",
+                        "idle",
+                        "mixed",
+                        "down",
+                        "drain",
+                        "maintenance",
+                        "unknown"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.NodeState"
+                        }
+                    ],
+                    "example": "drain"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Kill task failed"
+                },
+                "subCluster": {
+                    "description": "Looked up in the cluster config if empty",
+                    "type": "string",
+                    "example": "main"
+                }
+            }
+        },
+        "api.StartJobApiResponse": {
+            "type": "object",
+            "properties": {
+                "msg": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.StopJobApiRequest": {
+            "type": "object",
+            "required": [
+                "jobState",
+                "stopTime"
+            ],
+            "properties": {
+                "cluster": {
+                    "type": "string",
+                    "example": "fritz"
+                },
+                "jobId": {
+                    "type": "integer",
+                    "example": 123000
+                },
+                "jobState": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.JobState"
+                        }
+                    ],
+                    "example": "completed"
                 },
-                "username": {
-                    "type": "string"
+                "startTime": {
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "stopTime": {
+                    "type": "integer",
+                    "example": 1649763839
                 }
             }
         },
-        "api.ApiTag": {
+        "api.UpdateJobApiRequest": {
             "type": "object",
             "properties": {
-                "name": {
-                    "description": "Tag Name",
+                "partition": {
+                    "description": "New partition",
                     "type": "string",
-                    "example": "Testjob"
+                    "example": "main"
                 },
-                "scope": {
-                    "description": "Tag Scope for Frontend Display",
-                    "type": "string",
-                    "example": "global"
+                "resources": {
+                    "description": "New resources, replacing the node list",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.Resource"
+                    }
                 },
-                "type": {
-                    "description": "Tag Type",
-                    "type": "string",
-                    "example": "Debug"
+                "walltime": {
+                    "description": "New walltime in seconds",
+                    "type": "integer",
+                    "example": 86400
                 }
             }
         },
-        "api.DeleteJobApiRequest": {
+        "api.UpdateNodeStatesRequest": {
             "type": "object",
             "required": [
-                "jobId"
+                "cluster",
+                "nodes"
             ],
             "properties": {
                 "cluster": {
-                    "description": "Cluster of job",
                     "type": "string",
                     "example": "fritz"
                 },
-                "jobId": {
-                    "description": "Cluster Job ID of job",
-                    "type": "integer",
-                    "example": 123000
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.NodeStateApiPayload"
+                    }
                 },
-                "startTime": {
-                    "description": "Start Time of job as epoch",
+                "timeStamp": {
+                    "description": "Unix timestamp of the states, defaults to the time of the request",
                     "type": "integer",
-                    "example": 1649723812
+                    "example": 1649724812
                 }
             }
         },
-        "api.DeleteJobApiResponse": {
+        "api.WebhookApiRequest": {
             "type": "object",
             "properties": {
-                "msg": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.WebhookEvent"
+                    },
+                    "example": [
+                        "job_started",
+                        "job_stopped"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "chat"
+                },
+                "secret": {
+                    "description": "Key of the payload signature, an empty string generates a new one",
                     "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://chat.example.com/hooks/cc"
                 }
             }
         },
-        "api.EditMetaRequest": {
+        "model.FloatRange": {
             "type": "object",
             "properties": {
-                "key": {
-                    "type": "string",
-                    "example": "jobScript"
+                "from": {
+                    "type": "number"
                 },
-                "value": {
-                    "type": "string",
-                    "example": "bash script"
+                "to": {
+                    "type": "number"
                 }
             }
         },
-        "api.ErrorResponse": {
+        "model.JobFilter": {
             "type": "object",
             "properties": {
-                "error": {
-                    "description": "Error Message",
+                "arrayJobId": {
+                    "type": "integer"
+                },
+                "cluster": {
+                    "$ref": "#/definitions/model.StringInput"
+                },
+                "collection": {
                     "type": "string"
                 },
-                "status": {
-                    "description": "Statustext of Errorcode",
+                "duration": {
+                    "$ref": "#/definitions/schema.IntRange"
+                },
+                "energy": {
+                    "$ref": "#/definitions/model.FloatRange"
+                },
+                "exclusive": {
+                    "type": "integer"
+                },
+                "jobId": {
+                    "$ref": "#/definitions/model.StringInput"
+                },
+                "jobName": {
+                    "$ref": "#/definitions/model.StringInput"
+                },
+                "metricStats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MetricStatItem"
+                    }
+                },
+                "minRunningFor": {
+                    "type": "integer"
+                },
+                "node": {
+                    "$ref": "#/definitions/model.StringInput"
+                },
+                "numAccelerators": {
+                    "$ref": "#/definitions/schema.IntRange"
+                },
+                "numHWThreads": {
+                    "$ref": "#/definitions/schema.IntRange"
+                },
+                "numNodes": {
+                    "$ref": "#/definitions/schema.IntRange"
+                },
+                "partition": {
+                    "$ref": "#/definitions/model.StringInput"
+                },
+                "project": {
+                    "$ref": "#/definitions/model.StringInput"
+                },
+                "savedFilter": {
+                    "type": "string"
+                },
+                "search": {
                     "type": "string"
+                },
+                "startTime": {
+                    "$ref": "#/definitions/schema.TimeRange"
+                },
+                "state": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.JobState"
+                    }
+                },
+                "submitTime": {
+                    "$ref": "#/definitions/schema.TimeRange"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/model.StringInput"
+                },
+                "waitTime": {
+                    "$ref": "#/definitions/schema.IntRange"
                 }
             }
         },
-        "api.GetClustersApiResponse": {
+        "model.MetricStatItem": {
             "type": "object",
             "properties": {
-                "clusters": {
-                    "description": "Array of clusters",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schema.Cluster"
-                    }
+                "metricName": {
+                    "type": "string"
+                },
+                "range": {
+                    "$ref": "#/definitions/model.FloatRange"
                 }
             }
         },
-        "api.GetJobApiResponse": {
+        "model.OrderByInput": {
             "type": "object",
             "properties": {
-                "data": {
+                "field": {
+                    "type": "string"
+                },
+                "order": {
+                    "$ref": "#/definitions/model.SortDirectionEnum"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.SortDirectionEnum": {
+            "type": "string",
+            "enum": [
+                "DESC",
+                "ASC"
+            ],
+            "x-enum-varnames": [
+                "SortDirectionEnumDesc",
+                "SortDirectionEnumAsc"
+            ]
+        },
+        "model.StringInput": {
+            "type": "object",
+            "properties": {
+                "contains": {
+                    "type": "string"
+                },
+                "endsWith": {
+                    "type": "string"
+                },
+                "eq": {
+                    "type": "string"
+                },
+                "in": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/api.JobMetricWithName"
+                        "type": "string"
                     }
                 },
-                "meta": {
-                    "$ref": "#/definitions/schema.Job"
+                "neq": {
+                    "type": "string"
+                },
+                "startsWith": {
+                    "type": "string"
                 }
             }
         },
-        "api.GetJobsApiResponse": {
+        "model.UtilizationPoint": {
             "type": "object",
             "properties": {
-                "items": {
-                    "description": "Number of jobs returned",
-                    "type": "integer"
+                "accs": {
+                    "type": "number"
+                },
+                "cores": {
+                    "type": "number"
                 },
                 "jobs": {
-                    "description": "Array of jobs",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schema.JobMeta"
-                    }
+                    "type": "number"
                 },
-                "page": {
-                    "description": "Page id returned",
-                    "type": "integer"
+                "nodes": {
+                    "type": "number"
+                },
+                "time": {
+                    "type": "string"
                 }
             }
         },
-        "api.JobMetricWithName": {
+        "repository.DeletedJob": {
             "type": "object",
             "properties": {
-                "metric": {
-                    "$ref": "#/definitions/schema.JobMetric"
+                "cluster": {
+                    "type": "string"
                 },
-                "name": {
+                "deletedAt": {
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "Empty if deleted by cc-backend itself",
                     "type": "string"
                 },
-                "scope": {
-                    "$ref": "#/definitions/schema.MetricScope"
+                "id": {
+                    "type": "integer"
+                },
+                "jobId": {
+                    "type": "integer"
+                },
+                "startTime": {
+                    "type": "integer"
                 }
             }
         },
-        "api.StartJobApiResponse": {
+        "schema.Accelerator": {
             "type": "object",
             "properties": {
-                "msg": {
+                "id": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "api.StopJobApiRequest": {
+        "schema.ApiToken": {
+            "description": "A named API token of a user. The token itself is only stored as hash.",
             "type": "object",
-            "required": [
-                "jobState",
-                "stopTime"
-            ],
             "properties": {
-                "cluster": {
-                    "type": "string",
-                    "example": "fritz"
+                "clusters": {
+                    "description": "Clusters the token is restricted to, empty for all clusters",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "fritz"
+                    ]
                 },
-                "jobId": {
+                "createdAt": {
+                    "description": "Unix timestamp",
                     "type": "integer",
-                    "example": 123000
+                    "example": 1649723812
                 },
-                "jobState": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/schema.JobState"
-                        }
-                    ],
-                    "example": "completed"
+                "expiresAt": {
+                    "description": "Unix timestamp, 0 if the token does not expire",
+                    "type": "integer",
+                    "example": 1681259812
                 },
-                "startTime": {
+                "id": {
+                    "type": "integer"
+                },
+                "lastUsed": {
+                    "description": "Unix timestamp",
                     "type": "integer",
                     "example": 1649723812
                 },
-                "stopTime": {
-                    "type": "integer",
-                    "example": 1649763839
+                "name": {
+                    "type": "string",
+                    "example": "slurm-adapter"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ApiTokenScope"
+                    },
+                    "example": [
+                        "job_control"
+                    ]
+                },
+                "token": {
+                    "description": "Only returned on creation",
+                    "type": "string",
+                    "example": "cct_5f0c..."
+                },
+                "username": {
+                    "type": "string",
+                    "example": "abcd100h"
                 }
             }
         },
-        "schema.Accelerator": {
+        "schema.ApiTokenScope": {
+            "type": "string",
+            "enum": [
+                "read",
+                "job_control",
+                "job_edit",
+                "node_state",
+                "all"
+            ],
+            "x-enum-comments": {
+                "ApiTokenScopeAll": "Everything the user is allowed to do",
+                "ApiTokenScopeJobControl": "Start, stop and update jobs",
+                "ApiTokenScopeJobEdit": "Tag, edit, import, delete and restore jobs",
+                "ApiTokenScopeNodeState": "Update node and machine states",
+                "ApiTokenScopeRead": "Requests that do not change anything"
+            },
+            "x-enum-varnames": [
+                "ApiTokenScopeRead",
+                "ApiTokenScopeJobControl",
+                "ApiTokenScopeJobEdit",
+                "ApiTokenScopeNodeState",
+                "ApiTokenScopeAll"
+            ]
+        },
+        "schema.AuditEntry": {
+            "description": "An entry of the audit log of administrative and API actions.",
             "type": "object",
             "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "DELETE /api/jobs/delete_job/{id}"
+                },
+                "authMethod": {
+                    "type": "string",
+                    "example": "session:ldap"
+                },
                 "id": {
-                    "type": "string"
+                    "type": "integer"
                 },
-                "model": {
+                "payload": {
+                    "description": "Summary of the request payload, secrets are redacted",
                     "type": "string"
                 },
-                "type": {
-                    "type": "string"
+                "sourceIp": {
+                    "type": "string",
+                    "example": "10.0.0.1"
+                },
+                "status": {
+                    "description": "HTTP status of the response",
+                    "type": "integer",
+                    "example": 200
+                },
+                "target": {
+                    "type": "string",
+                    "example": "/api/jobs/delete_job/123"
+                },
+                "timeStamp": {
+                    "description": "Unix timestamp",
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "username": {
+                    "type": "string",
+                    "example": "abcd100h"
                 }
             }
         },
@@ -1405,6 +3677,32 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.HealthState": {
+            "type": "string",
+            "enum": [
+                "full",
+                "partial",
+                "failed",
+                "unknown"
+            ],
+            "x-enum-varnames": [
+                "HealthStateFull",
+                "HealthStatePartial",
+                "HealthStateFailed",
+                "HealthStateUnknown"
+            ]
+        },
+        "schema.IntRange": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "integer"
+                },
+                "to": {
+                    "type": "integer"
+                }
+            }
+        },
         "schema.Job": {
             "description": "Information of a HPC job.",
             "type": "object",
@@ -1417,6 +3715,12 @@ const docTemplate = `{
                     "type": "string",
                     "example": "fritz"
                 },
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.JobComment"
+                    }
+                },
                 "concurrentJobs": {
                     "$ref": "#/definitions/schema.JobLinkResultList"
                 },
@@ -1425,6 +3729,12 @@ const docTemplate = `{
                     "minimum": 1,
                     "example": 43200
                 },
+                "eligibleTime": {
+                    "description": "Zero if unknown",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1649720000
+                },
                 "energy": {
                     "type": "number"
                 },
@@ -1521,6 +3831,12 @@ const docTemplate = `{
                     "type": "string",
                     "example": "main"
                 },
+                "submitTime": {
+                    "description": "Zero if unknown",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1649720000
+                },
                 "tags": {
                     "type": "array",
                     "items": {
@@ -1538,6 +3854,114 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.JobComment": {
+            "description": "A comment on a job. If a time range is set, the comment annotates the metric plots of the job.",
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string",
+                    "example": "abcd100h"
+                },
+                "createdAt": {
+                    "type": "integer",
+                    "example": 1649730000
+                },
+                "from": {
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "metric": {
+                    "description": "Annotated metric, all metrics if empty",
+                    "type": "string",
+                    "example": "mem_bw"
+                },
+                "parentId": {
+                    "description": "Comment this comment is a reply to",
+                    "type": "integer"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "global"
+                },
+                "text": {
+                    "type": "string",
+                    "example": "This job hit a Lustre issue"
+                },
+                "to": {
+                    "type": "integer",
+                    "example": 1649727412
+                }
+            }
+        },
+        "schema.JobEvent": {
+            "description": "An entry of the lifecycle of a job.",
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "Empty if triggered by cc-backend itself",
+                    "type": "string",
+                    "example": "abcd100h"
+                },
+                "details": {
+                    "type": "string",
+                    "example": "Debug:Testjob"
+                },
+                "event": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.JobEventType"
+                        }
+                    ],
+                    "example": "stop"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "jobState": {
+                    "description": "Job state after the event, if it changed the job state",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.JobState"
+                        }
+                    ],
+                    "example": "completed"
+                },
+                "timeStamp": {
+                    "type": "integer",
+                    "example": 1649723812
+                }
+            }
+        },
+        "schema.JobEventType": {
+            "type": "string",
+            "enum": [
+                "start",
+                "stop",
+                "archived",
+                "archiving_failed",
+                "metadata",
+                "tag",
+                "untag",
+                "delete",
+                "restore",
+                "update"
+            ],
+            "x-enum-varnames": [
+                "JobEventStart",
+                "JobEventStop",
+                "JobEventArchived",
+                "JobEventArchivingFailed",
+                "JobEventMetadata",
+                "JobEventTag",
+                "JobEventUntag",
+                "JobEventDelete",
+                "JobEventRestore",
+                "JobEventUpdate"
+            ]
+        },
         "schema.JobLink": {
             "type": "object",
             "properties": {
@@ -1575,6 +3999,12 @@ const docTemplate = `{
                     "type": "string",
                     "example": "fritz"
                 },
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.JobComment"
+                    }
+                },
                 "concurrentJobs": {
                     "$ref": "#/definitions/schema.JobLinkResultList"
                 },
@@ -1583,6 +4013,12 @@ const docTemplate = `{
                     "minimum": 1,
                     "example": 43200
                 },
+                "eligibleTime": {
+                    "description": "Zero if unknown",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1649720000
+                },
                 "energy": {
                     "type": "number"
                 },
@@ -1687,6 +4123,12 @@ const docTemplate = `{
                     "type": "string",
                     "example": "main"
                 },
+                "submitTime": {
+                    "description": "Zero if unknown",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1649720000
+                },
                 "tags": {
                     "type": "array",
                     "items": {
@@ -1707,6 +4149,10 @@ const docTemplate = `{
         "schema.JobMetric": {
             "type": "object",
             "properties": {
+                "originalTimestep": {
+                    "description": "Native timestep if the data was downsampled for archiving",
+                    "type": "integer"
+                },
                 "series": {
                     "type": "array",
                     "items": {
@@ -1867,6 +4313,87 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.Node": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "type": "string",
+                    "example": "fritz"
+                },
+                "healthInfo": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "healthState": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.HealthState"
+                        }
+                    ],
+                    "example": "full"
+                },
+                "hostname": {
+                    "type": "string",
+                    "example": "f0101"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "inventory": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "nodeState": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.NodeState"
+                        }
+                    ],
+                    "example": "idle"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Not responding"
+                },
+                "stateSince": {
+                    "description": "Unix timestamp of the last state change",
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "subCluster": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "timeStamp": {
+                    "description": "Unix timestamp of the last update",
+                    "type": "integer",
+                    "example": 1649724812
+                }
+            }
+        },
+        "schema.NodeState": {
+            "type": "string",
+            "enum": [
+                "allocated",
+                "reserved",
+                "idle",
+                "mixed",
+                "down",
+                "drain",
+                "maintenance",
+                "unknown"
+            ],
+            "x-enum-varnames": [
+                "NodeStateAllocated",
+                "NodeStateReserved",
+                "NodeStateIdle",
+                "NodeStateMixed",
+                "NodeStateDown",
+                "NodeStateDrain",
+                "NodeStateMaintenance",
+                "NodeStateUnknown"
+            ]
+        },
         "schema.Resource": {
             "description": "A resource used by a job",
             "type": "object",
@@ -2055,6 +4582,20 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.TimeRange": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "range": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
         "schema.Topology": {
             "type": "object",
             "properties": {
@@ -2118,6 +4659,140 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "schema.Webhook": {
+            "description": "An endpoint that is notified about job lifecycle and node state events.",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "description": "Unix timestamp",
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "createdBy": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.WebhookEvent"
+                    },
+                    "example": [
+                        "job_started",
+                        "job_stopped"
+                    ]
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "chat"
+                },
+                "secret": {
+                    "description": "Key of the HMAC-SHA256 payload signature, only returned on creation",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://chat.example.com/hooks/cc"
+                }
+            }
+        },
+        "schema.WebhookDelivery": {
+            "description": "A request to a webhook, pending until it is delivered or all attempts failed.",
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "createdAt": {
+                    "description": "Unix timestamp",
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "deliveredAt": {
+                    "description": "Unix timestamp",
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "error": {
+                    "description": "Error of the last attempt",
+                    "type": "string"
+                },
+                "event": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.WebhookEvent"
+                        }
+                    ],
+                    "example": "job_started"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nextAttempt": {
+                    "description": "Unix timestamp",
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "responseStatus": {
+                    "description": "HTTP status of the last attempt, 0 if there was no response",
+                    "type": "integer",
+                    "example": 200
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.WebhookDeliveryStatus"
+                        }
+                    ],
+                    "example": "delivered"
+                },
+                "webhookId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "delivered",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliveryDelivered",
+                "WebhookDeliveryFailed"
+            ]
+        },
+        "schema.WebhookEvent": {
+            "type": "string",
+            "enum": [
+                "job_started",
+                "job_stopped",
+                "job_archived",
+                "job_archiving_failed",
+                "job_tagged",
+                "node_state_changed"
+            ],
+            "x-enum-varnames": [
+                "WebhookEventJobStarted",
+                "WebhookEventJobStopped",
+                "WebhookEventJobArchived",
+                "WebhookEventJobArchivingFailed",
+                "WebhookEventJobTagged",
+                "WebhookEventNodeStateChanged"
+            ]
         }
     },
     "securityDefinitions": {
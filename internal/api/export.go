@@ -0,0 +1,253 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	"github.com/parquet-go/parquet-go"
+)
+
+// Columns of a job export. Besides the fixed columns, footprint values are
+// selected as "footprint.<name>" and metadata keys as "meta.<key>".
+
+type exportKind int
+
+const (
+	exportString exportKind = iota
+	exportInt
+	exportFloat
+)
+
+type exportColumn struct {
+	name  string
+	kind  exportKind
+	value func(job *schema.Job) any // int64, float64, string or nil if the job has no value
+}
+
+var exportColumns = []*exportColumn{
+	{"id", exportInt, func(j *schema.Job) any { return j.ID }},
+	{"jobId", exportInt, func(j *schema.Job) any { return j.JobID }},
+	{"user", exportString, func(j *schema.Job) any { return j.User }},
+	{"project", exportString, func(j *schema.Job) any { return j.Project }},
+	{"cluster", exportString, func(j *schema.Job) any { return j.Cluster }},
+	{"subCluster", exportString, func(j *schema.Job) any { return j.SubCluster }},
+	{"partition", exportString, func(j *schema.Job) any { return j.Partition }},
+	{"arrayJobId", exportInt, func(j *schema.Job) any { return j.ArrayJobId }},
+	{"startTime", exportInt, func(j *schema.Job) any { return j.StartTimeUnix }},
+	{"duration", exportInt, func(j *schema.Job) any { return int64(j.Duration) }},
+	{"walltime", exportInt, func(j *schema.Job) any { return j.Walltime }},
+	{"numNodes", exportInt, func(j *schema.Job) any { return int64(j.NumNodes) }},
+	{"numHwthreads", exportInt, func(j *schema.Job) any { return int64(j.NumHWThreads) }},
+	{"numAcc", exportInt, func(j *schema.Job) any { return int64(j.NumAcc) }},
+	{"exclusive", exportInt, func(j *schema.Job) any { return int64(j.Exclusive) }},
+	{"smt", exportInt, func(j *schema.Job) any { return int64(j.SMT) }},
+	{"jobState", exportString, func(j *schema.Job) any { return string(j.State) }},
+	{"monitoringStatus", exportInt, func(j *schema.Job) any { return int64(j.MonitoringStatus) }},
+	{"energy", exportFloat, func(j *schema.Job) any { return j.Energy }},
+	{"nodes", exportString, func(j *schema.Job) any {
+		hosts := make([]string, 0, len(j.Resources))
+		for _, r := range j.Resources {
+			hosts = append(hosts, r.Hostname)
+		}
+		return strings.Join(hosts, ",")
+	}},
+}
+
+var defaultExportColumns = []string{
+	"id", "jobId", "user", "project", "cluster", "subCluster", "partition",
+	"startTime", "duration", "numNodes", "numHwthreads", "numAcc", "jobState", "energy",
+}
+
+// Resolve the requested column names. The second result is true if a
+// metadata key was selected.
+func parseExportColumns(names []string) ([]*exportColumn, bool, error) {
+	if len(names) == 0 {
+		names = defaultExportColumns
+	}
+
+	columns := make([]*exportColumn, 0, len(names))
+	withMetadata := false
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			return nil, false, fmt.Errorf("duplicate export column: %s", name)
+		}
+		seen[name] = true
+
+		if metric, ok := strings.CutPrefix(name, "footprint."); ok && metric != "" {
+			columns = append(columns, &exportColumn{name, exportFloat, func(j *schema.Job) any {
+				if v, ok := j.Footprint[metric]; ok {
+					return v
+				}
+				return nil
+			}})
+			continue
+		}
+		if key, ok := strings.CutPrefix(name, "meta."); ok && key != "" {
+			withMetadata = true
+			columns = append(columns, &exportColumn{name, exportString, func(j *schema.Job) any {
+				if v, ok := j.MetaData[key]; ok {
+					return v
+				}
+				return nil
+			}})
+			continue
+		}
+
+		found := false
+		for _, c := range exportColumns {
+			if c.name == name {
+				columns, found = append(columns, c), true
+				break
+			}
+		}
+		if !found {
+			return nil, false, fmt.Errorf("unknown export column: %s", name)
+		}
+	}
+
+	return columns, withMetadata, nil
+}
+
+// A jobExporter writes the selected columns of each job to the output.
+type jobExporter interface {
+	Write(job *schema.Job) error
+	Close() error
+}
+
+var exportContentTypes = map[string]string{
+	"csv":     "text/csv",
+	"ndjson":  "application/x-ndjson",
+	"parquet": "application/vnd.apache.parquet",
+}
+
+func newJobExporter(format string, w io.Writer, columns []*exportColumn) (jobExporter, error) {
+	switch format {
+	case "csv":
+		return newCsvExporter(w, columns)
+	case "ndjson":
+		return &ndjsonExporter{enc: json.NewEncoder(w), columns: columns}, nil
+	case "parquet":
+		return newParquetExporter(w, columns), nil
+	default:
+		return nil, fmt.Errorf("unknown export format: %s", format)
+	}
+}
+
+type csvExporter struct {
+	cw      *csv.Writer
+	columns []*exportColumn
+	record  []string
+}
+
+func newCsvExporter(w io.Writer, columns []*exportColumn) (*csvExporter, error) {
+	e := &csvExporter{cw: csv.NewWriter(w), columns: columns, record: make([]string, len(columns))}
+	for i, c := range columns {
+		e.record[i] = c.name
+	}
+	return e, e.cw.Write(e.record)
+}
+
+func (e *csvExporter) Write(job *schema.Job) error {
+	for i, c := range e.columns {
+		switch v := c.value(job).(type) {
+		case int64:
+			e.record[i] = strconv.FormatInt(v, 10)
+		case float64:
+			e.record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		case string:
+			e.record[i] = v
+		default:
+			e.record[i] = ""
+		}
+	}
+	return e.cw.Write(e.record)
+}
+
+func (e *csvExporter) Close() error {
+	e.cw.Flush()
+	return e.cw.Error()
+}
+
+type ndjsonExporter struct {
+	enc     *json.Encoder
+	columns []*exportColumn
+}
+
+func (e *ndjsonExporter) Write(job *schema.Job) error {
+	record := make(map[string]any, len(e.columns))
+	for _, c := range e.columns {
+		record[c.name] = c.value(job)
+	}
+	return e.enc.Encode(record)
+}
+
+func (e *ndjsonExporter) Close() error {
+	return nil
+}
+
+type parquetExporter struct {
+	pw      *parquet.Writer
+	columns []*exportColumn
+	// Column index in the parquet schema per export column
+	index []int
+	row   parquet.Row
+}
+
+func newParquetExporter(w io.Writer, columns []*exportColumn) *parquetExporter {
+	group := make(parquet.Group, len(columns))
+	for _, c := range columns {
+		switch c.kind {
+		case exportInt:
+			group[c.name] = parquet.Optional(parquet.Int(64))
+		case exportFloat:
+			group[c.name] = parquet.Optional(parquet.Leaf(parquet.DoubleType))
+		default:
+			group[c.name] = parquet.Optional(parquet.String())
+		}
+	}
+
+	// The fields of a group are ordered by name
+	index := make([]int, len(columns))
+	fields := group.Fields()
+	for i, c := range columns {
+		for j, f := range fields {
+			if f.Name() == c.name {
+				index[i] = j
+			}
+		}
+	}
+
+	s := parquet.NewSchema("job", group)
+	return &parquetExporter{
+		pw:      parquet.NewWriter(w, s, parquet.Compression(&parquet.Zstd)),
+		columns: columns,
+		index:   index,
+		row:     make(parquet.Row, len(columns)),
+	}
+}
+
+func (e *parquetExporter) Write(job *schema.Job) error {
+	for i, c := range e.columns {
+		if v := c.value(job); v != nil {
+			e.row[e.index[i]] = parquet.ValueOf(v).Level(0, 1, e.index[i])
+		} else {
+			e.row[e.index[i]] = parquet.NullValue().Level(0, 0, e.index[i])
+		}
+	}
+	_, err := e.pw.WriteRows([]parquet.Row{e.row})
+	return err
+}
+
+func (e *parquetExporter) Close() error {
+	return e.pw.Close()
+}
@@ -0,0 +1,34 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MetricsHandler serves the Prometheus metrics of cc-backend itself (e.g. of
+// the metric data disk cache). It is mounted outside of the REST API, so
+// that scrapers do not need a JWT and scrapes are not audited. If a token is
+// configured, scrapers have to send it as bearer token.
+func MetricsHandler(cfg *schema.MetricsEndpointConfig) http.Handler {
+	handler := promhttp.Handler()
+	if cfg.Token == "" {
+		return handler
+	}
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(cfg.Token)) != 1 {
+			http.Error(rw, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(rw, r)
+	})
+}
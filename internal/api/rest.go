@@ -6,14 +6,19 @@ package api
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"database/sql"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -28,6 +33,7 @@ import (
 	"github.com/ClusterCockpit/cc-backend/internal/metricDataDispatcher"
 	"github.com/ClusterCockpit/cc-backend/internal/repository"
 	"github.com/ClusterCockpit/cc-backend/internal/util"
+	"github.com/ClusterCockpit/cc-backend/internal/webhook"
 	"github.com/ClusterCockpit/cc-backend/pkg/archive"
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
@@ -69,22 +75,48 @@ func New() *RestApi {
 
 func (api *RestApi) MountApiRoutes(r *mux.Router) {
 	r.StrictSlash(true)
+	r.Use(auditRequests)
 
 	r.HandleFunc("/jobs/start_job/", api.startJob).Methods(http.MethodPost, http.MethodPut)
 	r.HandleFunc("/jobs/stop_job/", api.stopJobByRequest).Methods(http.MethodPost, http.MethodPut)
-	// r.HandleFunc("/jobs/import/", api.importJob).Methods(http.MethodPost, http.MethodPut)
+	r.HandleFunc("/jobs/start_jobs/", api.startJobs).Methods(http.MethodPost, http.MethodPut)
+	r.HandleFunc("/jobs/stop_jobs/", api.stopJobs).Methods(http.MethodPost, http.MethodPut)
+	r.HandleFunc("/jobs/import/", api.importJob).Methods(http.MethodPost, http.MethodPut)
 
 	r.HandleFunc("/jobs/", api.getJobs).Methods(http.MethodGet)
+	r.HandleFunc("/jobs/export/", api.exportJobs).Methods(http.MethodPost)
+	r.HandleFunc("/jobs/deleted/", api.getDeletedJobs).Methods(http.MethodGet)
 	r.HandleFunc("/jobs/{id}", api.getJobById).Methods(http.MethodPost)
 	r.HandleFunc("/jobs/{id}", api.getCompleteJobById).Methods(http.MethodGet)
 	r.HandleFunc("/jobs/tag_job/{id}", api.tagJob).Methods(http.MethodPost, http.MethodPatch)
 	r.HandleFunc("/jobs/edit_meta/{id}", api.editMeta).Methods(http.MethodPost, http.MethodPatch)
+	r.HandleFunc("/jobs/update_job/{id}", api.updateJob).Methods(http.MethodPost, http.MethodPatch)
 	r.HandleFunc("/jobs/metrics/{id}", api.getJobMetrics).Methods(http.MethodGet)
+	r.HandleFunc("/jobs/events/{id}", api.getJobEvents).Methods(http.MethodGet)
 	r.HandleFunc("/jobs/delete_job/", api.deleteJobByRequest).Methods(http.MethodDelete)
 	r.HandleFunc("/jobs/delete_job/{id}", api.deleteJobById).Methods(http.MethodDelete)
 	r.HandleFunc("/jobs/delete_job_before/{ts}", api.deleteJobBefore).Methods(http.MethodDelete)
+	r.HandleFunc("/jobs/restore_job/{id}", api.restoreJob).Methods(http.MethodPost)
 
 	r.HandleFunc("/clusters/", api.getClusters).Methods(http.MethodGet)
+	r.HandleFunc("/clusters/utilization/{cluster}", api.getClusterUtilization).Methods(http.MethodGet)
+
+	r.HandleFunc("/audit/", api.getAuditLog).Methods(http.MethodGet)
+
+	r.HandleFunc("/webhooks/", api.getWebhooks).Methods(http.MethodGet)
+	r.HandleFunc("/webhooks/", api.createWebhook).Methods(http.MethodPost, http.MethodPut)
+	r.HandleFunc("/webhooks/{id}", api.updateWebhook).Methods(http.MethodPost, http.MethodPatch)
+	r.HandleFunc("/webhooks/{id}", api.deleteWebhook).Methods(http.MethodDelete)
+	r.HandleFunc("/webhooks/{id}/deliveries", api.getWebhookDeliveries).Methods(http.MethodGet)
+
+	r.HandleFunc("/tokens/", api.getApiTokens).Methods(http.MethodGet)
+	r.HandleFunc("/tokens/", api.createApiToken).Methods(http.MethodPost, http.MethodPut)
+	r.HandleFunc("/tokens/{id}", api.deleteApiToken).Methods(http.MethodDelete)
+
+	r.HandleFunc("/nodestate/", api.updateNodeStates).Methods(http.MethodPost, http.MethodPut)
+	r.HandleFunc("/nodestate/", api.getNodeStates).Methods(http.MethodGet)
+	r.HandleFunc("/nodestate/{cluster}", api.getNodeStates).Methods(http.MethodGet)
+	r.HandleFunc("/nodestate/{cluster}/{host}", api.getNodeState).Methods(http.MethodGet)
 
 	if api.MachineStateDir != "" {
 		r.HandleFunc("/machine_state/{cluster}/{host}", api.getMachineState).Methods(http.MethodGet)
@@ -96,13 +128,16 @@ func (api *RestApi) MountUserApiRoutes(r *mux.Router) {
 	r.StrictSlash(true)
 
 	r.HandleFunc("/jobs/", api.getJobs).Methods(http.MethodGet)
+	r.HandleFunc("/jobs/export/", api.exportJobs).Methods(http.MethodPost)
 	r.HandleFunc("/jobs/{id}", api.getJobById).Methods(http.MethodPost)
 	r.HandleFunc("/jobs/{id}", api.getCompleteJobById).Methods(http.MethodGet)
 	r.HandleFunc("/jobs/metrics/{id}", api.getJobMetrics).Methods(http.MethodGet)
+	r.HandleFunc("/jobs/events/{id}", api.getJobEvents).Methods(http.MethodGet)
 }
 
 func (api *RestApi) MountConfigApiRoutes(r *mux.Router) {
 	r.StrictSlash(true)
+	r.Use(auditRequests)
 
 	if api.Authentication != nil {
 		r.HandleFunc("/roles/", api.getRoles).Methods(http.MethodGet)
@@ -116,9 +151,13 @@ func (api *RestApi) MountConfigApiRoutes(r *mux.Router) {
 
 func (api *RestApi) MountFrontendApiRoutes(r *mux.Router) {
 	r.StrictSlash(true)
+	r.Use(auditRequests)
 
 	if api.Authentication != nil {
 		r.HandleFunc("/jwt/", api.getJWT).Methods(http.MethodGet)
+		r.HandleFunc("/tokens/", api.getApiTokens).Methods(http.MethodGet)
+		r.HandleFunc("/tokens/", api.createApiToken).Methods(http.MethodPost, http.MethodPut)
+		r.HandleFunc("/tokens/{id}", api.deleteApiToken).Methods(http.MethodDelete)
 		r.HandleFunc("/configuration/", api.updateConfiguration).Methods(http.MethodPost)
 	}
 }
@@ -137,6 +176,18 @@ type StopJobApiRequest struct {
 	StopTime  int64           `json:"stopTime" validate:"required" example:"1649763839"`
 }
 
+// BulkJobApiResult model
+type BulkJobApiResult struct {
+	Status int    `json:"status" example:"201"`            // HTTP status of the single request
+	DBID   int64  `json:"id,omitempty" example:"123000"`   // Database ID of the started or stopped job
+	Error  string `json:"error,omitempty" example:"Error"` // Error message if the request failed
+}
+
+// BulkJobApiResponse model
+type BulkJobApiResponse struct {
+	Results []BulkJobApiResult `json:"results"` // Results in the order of the requests
+}
+
 // DeleteJobApiRequest model
 type DeleteJobApiRequest struct {
 	JobId     *int64  `json:"jobId" validate:"required" example:"123000"` // Cluster Job ID of job
@@ -146,9 +197,24 @@ type DeleteJobApiRequest struct {
 
 // GetJobsApiResponse model
 type GetJobsApiResponse struct {
-	Jobs  []*schema.JobMeta `json:"jobs"`  // Array of jobs
-	Items int               `json:"items"` // Number of jobs returned
-	Page  int               `json:"page"`  // Page id returned
+	Jobs       []*schema.JobMeta `json:"jobs"`                 // Array of jobs
+	Items      int               `json:"items"`                // Number of jobs returned
+	Page       int               `json:"page"`                 // Page id returned
+	NextCursor *string           `json:"nextCursor,omitempty"` // Cursor of the next jobs (cursor pagination only)
+	PrevCursor *string           `json:"prevCursor,omitempty"` // Cursor of the previous jobs (cursor pagination only)
+}
+
+// ExportJobsApiRequest model
+type ExportJobsApiRequest struct {
+	Filter  []*model.JobFilter  `json:"filter"`                       // Job filters as for the GraphQL jobs query
+	Order   *model.OrderByInput `json:"order"`                        // Sort order (Default: descending startTime)
+	Format  string              `json:"format" example:"csv"`         // csv, ndjson or parquet (Default: csv)
+	Columns []string            `json:"columns" example:"jobId,user"` // Column names, 'footprint.<metric>' or 'meta.<key>'
+}
+
+// GetDeletedJobsApiResponse model
+type GetDeletedJobsApiResponse struct {
+	Jobs []*repository.DeletedJob `json:"jobs"` // Deleted jobs, most recently deleted first
 }
 
 // GetClustersApiResponse model
@@ -156,6 +222,11 @@ type GetClustersApiResponse struct {
 	Clusters []*schema.Cluster `json:"clusters"` // Array of clusters
 }
 
+// GetClusterUtilizationApiResponse model
+type GetClusterUtilizationApiResponse struct {
+	Utilization []*model.UtilizationPoint `json:"utilization"` // Allocated resources per time bucket
+}
+
 // ErrorResponse model
 type ErrorResponse struct {
 	// Statustext of Errorcode
@@ -177,6 +248,25 @@ type EditMetaRequest struct {
 	Value string `json:"value" example:"bash script"`
 }
 
+// ImportJobApiRequest model
+type ImportJobApiRequest struct {
+	Meta json.RawMessage `json:"meta" swaggertype:"object"` // Content of the meta.json of the job
+	Data json.RawMessage `json:"data" swaggertype:"object"` // Content of the data.json of the job
+}
+
+// ImportJobApiResponse model
+type ImportJobApiResponse struct {
+	DBID    int64  `json:"id" example:"123000"` // Database ID of the imported job
+	Message string `json:"msg" example:"success"`
+}
+
+// UpdateJobApiRequest model
+type UpdateJobApiRequest struct {
+	Walltime  *int64             `json:"walltime" example:"86400"` // New walltime in seconds
+	Partition *string            `json:"partition" example:"main"` // New partition
+	Resources []*schema.Resource `json:"resources"`                // New resources, replacing the node list
+}
+
 type TagJobApiRequest []*ApiTag
 
 type GetJobApiRequest []string
@@ -197,6 +287,76 @@ type JobMetricWithName struct {
 	Scope  schema.MetricScope `json:"scope"`
 }
 
+// UpdateNodeStatesRequest model
+type UpdateNodeStatesRequest struct {
+	Cluster   string                `json:"cluster" validate:"required" example:"fritz"`
+	TimeStamp int64                 `json:"timeStamp" example:"1649724812"` // Unix timestamp of the states, defaults to the time of the request
+	Nodes     []NodeStateApiPayload `json:"nodes" validate:"required"`
+}
+
+// NodeStateApiPayload model
+type NodeStateApiPayload struct {
+	Hostname    string                 `json:"hostname" validate:"required" example:"f0101"`
+	SubCluster  string                 `json:"subCluster" example:"main"` // Looked up in the cluster config if empty
+	NodeState   schema.NodeState       `json:"nodeState" validate:"required" example:"drain" enums:"allocated,reserved,idle,mixed,down,drain,maintenance,unknown"`
+	HealthState schema.HealthState     `json:"healthState" example:"partial" enums:"full,partial,failed,unknown"`
+	Reason      string                 `json:"reason" example:"Kill task failed"`
+	HealthInfo  map[string]interface{} `json:"healthInfo"` // Replaces the stored health info if set
+	Inventory   map[string]interface{} `json:"inventory"`  // Replaces the stored hardware inventory if set
+}
+
+// GetNodeStatesApiResponse model
+type GetNodeStatesApiResponse struct {
+	Nodes []*schema.Node `json:"nodes"` // Array of nodes
+}
+
+// GetJobEventsApiResponse model
+type GetJobEventsApiResponse struct {
+	Events []*schema.JobEvent `json:"events"` // Array of job events ordered by time
+}
+
+// GetAuditLogApiResponse model
+type GetAuditLogApiResponse struct {
+	Entries []*schema.AuditEntry `json:"entries"` // Array of audit log entries, newest first
+	Items   int                  `json:"items"`   // Number of entries per page
+	Page    int                  `json:"page"`    // Page id returned
+}
+
+// WebhookApiRequest model
+type WebhookApiRequest struct {
+	Name   *string               `json:"name" example:"chat"`
+	URL    *string               `json:"url" example:"https://chat.example.com/hooks/cc"`
+	Events []schema.WebhookEvent `json:"events" example:"job_started,job_stopped"`
+	Active *bool                 `json:"active" example:"true"`
+	Secret *string               `json:"secret"` // Key of the payload signature, an empty string generates a new one
+}
+
+// GetWebhooksApiResponse model
+type GetWebhooksApiResponse struct {
+	Webhooks []*schema.Webhook `json:"webhooks"` // Array of webhooks ordered by name, without secrets
+}
+
+// CreateApiTokenApiRequest model
+type CreateApiTokenApiRequest struct {
+	Name      string                 `json:"name" validate:"required" example:"slurm-adapter"`
+	Username  string                 `json:"username" example:"abcd100h"` // Owner of the token (Default: the requesting user), only admins can create tokens for others
+	Scopes    []schema.ApiTokenScope `json:"scopes" validate:"required" example:"job_control"`
+	Clusters  []string               `json:"clusters" example:"fritz"`       // Clusters to restrict the token to (Default: all clusters)
+	ExpiresAt int64                  `json:"expiresAt" example:"1681259812"` // Unix timestamp (Default: 0, the token does not expire)
+}
+
+// GetApiTokensApiResponse model
+type GetApiTokensApiResponse struct {
+	Tokens []*schema.ApiToken `json:"tokens"` // Array of tokens ordered by user and name, without the tokens themselves
+}
+
+// GetWebhookDeliveriesApiResponse model
+type GetWebhookDeliveriesApiResponse struct {
+	Deliveries []*schema.WebhookDelivery `json:"deliveries"` // Array of deliveries, newest first
+	Items      int                       `json:"items"`      // Number of deliveries per page
+	Page       int                       `json:"page"`       // Page id returned
+}
+
 type ApiReturnedUser struct {
 	Username string   `json:"username"`
 	Name     string   `json:"name"`
@@ -238,17 +398,7 @@ func securedCheck(r *http.Request) error {
 		}
 
 		// extract IP address
-		IPAddress := r.Header.Get("X-Real-Ip")
-		if IPAddress == "" {
-			IPAddress = r.Header.Get("X-Forwarded-For")
-		}
-		if IPAddress == "" {
-			IPAddress = r.RemoteAddr
-		}
-
-		if strings.Contains(IPAddress, ":") {
-			IPAddress = strings.Split(IPAddress, ":")[0]
-		}
+		IPAddress := sourceIP(r)
 
 		// check if IP is allowed
 		if !util.Contains(config.Keys.ApiAllowedIPs, IPAddress) {
@@ -308,6 +458,102 @@ func (api *RestApi) getClusters(rw http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// getClusterUtilization godoc
+// @summary     Get cluster utilization over time
+// @tags Cluster query
+// @description Get the nodes, cores and accelerators allocated by jobs per time bucket, averaged over the bucket.
+// @description Running jobs count until now. The utilization can be restricted to a subcluster or partition.
+// @produce     json
+// @param       cluster        path     string            true  "Cluster name"
+// @param       subcluster     query    string            false "Subcluster name"
+// @param       partition      query    string            false "Partition name"
+// @param       time           query    string            true  "Syntax: '$from-$to', as unix epoch timestamps in seconds"
+// @param       bucket-size    query    int               false "Bucket size in seconds (Default: 3600)"
+// @success     200            {object} api.GetClusterUtilizationApiResponse "Allocated resources per time bucket"
+// @failure     400            {object} api.ErrorResponse       "Bad Request"
+// @failure     401            {object} api.ErrorResponse       "Unauthorized"
+// @failure     403            {object} api.ErrorResponse       "Forbidden"
+// @failure     500            {object} api.ErrorResponse       "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /clusters/utilization/{cluster} [get]
+func (api *RestApi) getClusterUtilization(rw http.ResponseWriter, r *http.Request) {
+	if user := repository.GetUserFromContext(r.Context()); user != nil &&
+		!user.HasRole(schema.RoleApi) {
+
+		handleError(fmt.Errorf("missing role: %v", schema.GetRoleString(schema.RoleApi)), http.StatusForbidden, rw)
+		return
+	}
+
+	cluster := mux.Vars(r)["cluster"]
+	if archive.GetCluster(cluster) == nil {
+		handleError(fmt.Errorf("unknown cluster: %s", cluster), http.StatusBadRequest, rw)
+		return
+	}
+	if err := checkTokenCluster(r, cluster); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+
+	var subCluster, partition *string
+	var from, to int64
+	bucketSize := 3600
+	for key, vals := range r.URL.Query() {
+		switch key {
+		case "subcluster":
+			subCluster = &vals[0]
+		case "partition":
+			partition = &vals[0]
+		case "time":
+			st := strings.Split(vals[0], "-")
+			if len(st) != 2 {
+				handleError(fmt.Errorf("invalid query parameter value: time"),
+					http.StatusBadRequest, rw)
+				return
+			}
+			var err error
+			if from, err = strconv.ParseInt(st[0], 10, 64); err != nil {
+				handleError(err, http.StatusBadRequest, rw)
+				return
+			}
+			if to, err = strconv.ParseInt(st[1], 10, 64); err != nil {
+				handleError(err, http.StatusBadRequest, rw)
+				return
+			}
+		case "bucket-size":
+			x, err := strconv.Atoi(vals[0])
+			if err != nil {
+				handleError(err, http.StatusBadRequest, rw)
+				return
+			}
+			bucketSize = x
+		default:
+			handleError(fmt.Errorf("invalid query parameter: %s", key),
+				http.StatusBadRequest, rw)
+			return
+		}
+	}
+	if from == 0 && to == 0 {
+		handleError(fmt.Errorf("missing query parameter: time"), http.StatusBadRequest, rw)
+		return
+	}
+
+	points, err := api.JobRepository.ClusterUtilization(cluster, subCluster, partition,
+		time.Unix(from, 0), time.Unix(to, 0), bucketSize)
+	if err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	bw := bufio.NewWriter(rw)
+	defer bw.Flush()
+
+	if err := json.NewEncoder(bw).Encode(GetClusterUtilizationApiResponse{Utilization: points}); err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+}
+
 // getJobs godoc
 // @summary     Lists all jobs
 // @tags Job query
@@ -319,6 +565,7 @@ func (api *RestApi) getClusters(rw http.ResponseWriter, r *http.Request) {
 // @param       start-time     query    string            false "Syntax: '$from-$to', as unix epoch timestamps in seconds"
 // @param       items-per-page query    int               false "Items per page (Default: 25)"
 // @param       page           query    int               false "Page Number (Default: 1)"
+// @param       cursor         query    string            false "Cursor returned as nextCursor or prevCursor, empty for the first jobs. Replaces page."
 // @param       with-metadata  query    bool              false "Include metadata (e.g. jobScript) in response"
 // @success     200            {object} api.GetJobsApiResponse  "Job array and page info"
 // @failure     400            {object} api.ErrorResponse       "Bad Request"
@@ -329,6 +576,7 @@ func (api *RestApi) getClusters(rw http.ResponseWriter, r *http.Request) {
 // @router      /jobs/ [get]
 func (api *RestApi) getJobs(rw http.ResponseWriter, r *http.Request) {
 	withMetadata := false
+	var cursor *string
 	filter := &model.JobFilter{}
 	page := &model.PageRequest{ItemsPerPage: 25, Page: 1}
 	order := &model.OrderByInput{Field: "startTime", Type: "col", Order: model.SortDirectionEnumDesc}
@@ -380,6 +628,8 @@ func (api *RestApi) getJobs(rw http.ResponseWriter, r *http.Request) {
 				return
 			}
 			page.ItemsPerPage = x
+		case "cursor":
+			cursor = &vals[0]
 		case "with-metadata":
 			withMetadata = true
 		default:
@@ -389,7 +639,14 @@ func (api *RestApi) getJobs(rw http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	jobs, err := api.JobRepository.QueryJobs(r.Context(), []*model.JobFilter{filter}, page, order)
+	var jobs []*schema.Job
+	var next, prev *string
+	var err error
+	if cursor != nil {
+		jobs, next, prev, err = api.JobRepository.QueryJobsCursor(r.Context(), []*model.JobFilter{filter}, order, page.ItemsPerPage, *cursor)
+	} else {
+		jobs, err = api.JobRepository.QueryJobs(r.Context(), []*model.JobFilter{filter}, page, order)
+	}
 	if err != nil {
 		handleError(err, http.StatusInternalServerError, rw)
 		return
@@ -433,9 +690,11 @@ func (api *RestApi) getJobs(rw http.ResponseWriter, r *http.Request) {
 	defer bw.Flush()
 
 	payload := GetJobsApiResponse{
-		Jobs:  results,
-		Items: page.ItemsPerPage,
-		Page:  page.Page,
+		Jobs:       results,
+		Items:      page.ItemsPerPage,
+		Page:       page.Page,
+		NextCursor: next,
+		PrevCursor: prev,
 	}
 
 	if err := json.NewEncoder(bw).Encode(payload); err != nil {
@@ -444,6 +703,101 @@ func (api *RestApi) getJobs(rw http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// exportJobs godoc
+// @summary     Exports jobs
+// @tags Job query
+// @description Streams all jobs matching the filters as CSV, NDJSON or Parquet, not only a single page.
+// @description Columns can be selected by name, footprint values as 'footprint.<metric>' and metadata as 'meta.<key>'.
+// @description Only jobs visible to the user are exported.
+// @accept      json
+// @produce     text/csv,application/x-ndjson,application/vnd.apache.parquet
+// @param       request body     api.ExportJobsApiRequest true "Filters, format and columns"
+// @success     200     {file}   file                     "Exported jobs"
+// @failure     400     {object} api.ErrorResponse        "Bad Request"
+// @failure     401     {object} api.ErrorResponse        "Unauthorized"
+// @failure     403     {object} api.ErrorResponse        "Forbidden"
+// @failure     500     {object} api.ErrorResponse        "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /jobs/export/ [post]
+func (api *RestApi) exportJobs(rw http.ResponseWriter, r *http.Request) {
+	req := ExportJobsApiRequest{Format: "csv"}
+	if err := decode(r.Body, &req); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	contentType, ok := exportContentTypes[req.Format]
+	if !ok {
+		handleError(fmt.Errorf("unknown export format: %s", req.Format), http.StatusBadRequest, rw)
+		return
+	}
+	columns, withMetadata, err := parseExportColumns(req.Columns)
+	if err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+
+	user := repository.GetUserFromContext(r.Context())
+	if user == nil {
+		handleError(fmt.Errorf("missing user"), http.StatusUnauthorized, rw)
+		return
+	}
+	filter, err := repository.GetSavedFilterRepository().Expand(user, req.Filter)
+	if err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+	if err := repository.GetCollectionRepository().CheckFilter(user, filter); err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+	order := req.Order
+	if order == nil {
+		order = &model.OrderByInput{Field: "startTime", Type: "col", Order: model.SortDirectionEnumDesc}
+	}
+	if err := repository.ValidateJobOrder(order); err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+
+	// The response is only started with the first job, so that a failing
+	// query is still reported with an error status
+	var bw *bufio.Writer
+	var exporter jobExporter
+	start := func() (err error) {
+		rw.Header().Add("Content-Type", contentType)
+		rw.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=\"jobs.%s\"", req.Format))
+		bw = bufio.NewWriter(rw)
+		exporter, err = newJobExporter(req.Format, bw, columns)
+		return err
+	}
+
+	err = api.JobRepository.StreamJobs(r.Context(), filter, order, withMetadata, func(job *schema.Job) error {
+		if exporter == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		return exporter.Write(job)
+	})
+	if err != nil && bw == nil {
+		handleError(fmt.Errorf("exporting jobs failed: %w", err), http.StatusInternalServerError, rw)
+		return
+	}
+	if err == nil && bw == nil {
+		// No jobs, the output has a header only
+		err = start()
+	}
+	if err == nil {
+		err = exporter.Close()
+	}
+	bw.Flush()
+	if err != nil {
+		// The response is already partially written, it ends early
+		log.Errorf("REST > exporting jobs failed: %v", err)
+	}
+}
+
 // getCompleteJobById godoc
 // @summary   Get job meta and optional all metric data
 // @tags Job query
@@ -670,6 +1024,10 @@ func (api *RestApi) editMeta(rw http.ResponseWriter, r *http.Request) {
 		http.Error(rw, err.Error(), http.StatusNotFound)
 		return
 	}
+	if err := checkTokenCluster(r, job.Cluster); err != nil {
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
 
 	var req EditMetaRequest
 	if err := decode(r.Body, &req); err != nil {
@@ -681,12 +1039,132 @@ func (api *RestApi) editMeta(rw http.ResponseWriter, r *http.Request) {
 		http.Error(rw, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	api.JobRepository.AddJobEvent(job.ID, schema.JobEventMetadata, "", repository.GetUserFromContext(r.Context()), req.Key)
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(job)
+}
+
+// updateJob godoc
+// @summary     Update a running job
+// @tags Job add and modify
+// @description Changes the walltime, the partition or the resources of a running job, e.g. for walltime extensions or resized jobs.
+// @description Fields missing in the request are not changed. New resources replace the node list, the numbers of nodes, hwthreads and accelerators are recomputed from them.
+// @description If the resources only list hostnames, exclusive jobs get all hwthreads and accelerators of their nodes and shared jobs keep their numbers.
+// @description The change is recorded as job event.
+// @accept      json
+// @produce     json
+// @param       id      path     int                      true "Job Database ID"
+// @param       request body     api.UpdateJobApiRequest  true "Fields to change"
+// @success     200     {object} schema.Job                    "Updated job resource"
+// @failure     400     {object} api.ErrorResponse             "Bad Request"
+// @failure     401     {object} api.ErrorResponse             "Unauthorized"
+// @failure     404     {object} api.ErrorResponse             "Job does not exist"
+// @failure     422     {object} api.ErrorResponse             "Unprocessable Entity: job is not running"
+// @failure     500     {object} api.ErrorResponse             "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /jobs/update_job/{id} [patch]
+func (api *RestApi) updateJob(rw http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		handleError(fmt.Errorf("integer expected in path for id: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	var req UpdateJobApiRequest
+	if err := decode(r.Body, &req); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+	if req.Walltime == nil && req.Partition == nil && req.Resources == nil {
+		handleError(errors.New("at least one of 'walltime', 'partition' or 'resources' is required"), http.StatusBadRequest, rw)
+		return
+	}
+
+	// aquire lock to avoid race condition with stopping the job
+	api.RepositoryMutex.Lock()
+	defer api.RepositoryMutex.Unlock()
+
+	job, err := api.JobRepository.FindById(r.Context(), id)
+	if err != nil {
+		handleError(fmt.Errorf("finding job failed: %w", err), http.StatusNotFound, rw)
+		return
+	}
+	if err := checkTokenCluster(r, job.Cluster); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+	if job.State != schema.JobStateRunning {
+		handleError(fmt.Errorf("jobId %d (id %d) on %s : only running jobs can be updated (state is: %s)", job.JobID, job.ID, job.Cluster, job.State), http.StatusUnprocessableEntity, rw)
+		return
+	}
+
+	// Changes as 'field: old -> new' for the job event details
+	changes := make([]string, 0, 3)
+	if req.Walltime != nil {
+		if *req.Walltime < 0 {
+			handleError(errors.New("'walltime' must not be negative"), http.StatusBadRequest, rw)
+			return
+		}
+		changes = append(changes, fmt.Sprintf("walltime: %d -> %d", job.Walltime, *req.Walltime))
+		job.Walltime = *req.Walltime
+	}
+	if req.Partition != nil {
+		changes = append(changes, fmt.Sprintf("partition: %s -> %s", job.Partition, *req.Partition))
+		job.Partition = *req.Partition
+	}
+	oldNodes, oldHWThreads, oldAcc := job.NumNodes, job.NumHWThreads, job.NumAcc
+	if req.Resources != nil {
+		// The subcluster is assigned again for the new nodes
+		job.Resources, job.SubCluster = req.Resources, ""
+		job.NumNodes = int32(len(req.Resources))
+	}
+
+	if err := importer.SanityChecks(&job.BaseJob); err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+
+	if req.Resources != nil {
+		job.NumHWThreads, job.NumAcc = countResources(job)
+		changes = append(changes, fmt.Sprintf("resources: %d nodes (%d hwthreads, %d accelerators) -> %d nodes (%d hwthreads, %d accelerators)",
+			oldNodes, oldHWThreads, oldAcc, job.NumNodes, job.NumHWThreads, job.NumAcc))
+	}
+
+	if err := api.JobRepository.UpdateRunning(repository.GetUserFromContext(r.Context()), job, strings.Join(changes, ", ")); err != nil {
+		handleError(fmt.Errorf("updating job failed: %w", err), http.StatusUnprocessableEntity, rw)
+		return
+	}
 
+	log.Printf("updated job (id: %d): cluster=%s, jobId=%d, %s", job.ID, job.Cluster, job.JobID, strings.Join(changes, ", "))
 	rw.Header().Add("Content-Type", "application/json")
 	rw.WriteHeader(http.StatusOK)
 	json.NewEncoder(rw).Encode(job)
 }
 
+// Numbers of hwthreads and accelerators of the resources of a job. If the
+// resources only list the hostnames, whole nodes of exclusive jobs are
+// counted by the topology of the subcluster. The stored numbers are kept for
+// jobs sharing their nodes.
+func countResources(job *schema.Job) (numHWThreads int32, numAcc int32) {
+	listed := false
+	for _, res := range job.Resources {
+		numHWThreads += int32(len(res.HWThreads))
+		numAcc += int32(len(res.Accelerators))
+		listed = listed || res.HWThreads != nil || res.Accelerators != nil
+	}
+	if listed {
+		return numHWThreads, numAcc
+	}
+
+	sc, err := archive.GetSubCluster(job.Cluster, job.SubCluster)
+	if job.Exclusive != 1 || err != nil {
+		return job.NumHWThreads, job.NumAcc
+	}
+	return job.NumNodes * int32(len(sc.Topology.Node)), job.NumNodes * int32(len(sc.Topology.Accelerators))
+}
+
 // tagJob godoc
 // @summary     Adds one or more tags to a job
 // @tags Job add and modify
@@ -716,6 +1194,10 @@ func (api *RestApi) tagJob(rw http.ResponseWriter, r *http.Request) {
 		http.Error(rw, err.Error(), http.StatusNotFound)
 		return
 	}
+	if err := checkTokenCluster(r, job.Cluster); err != nil {
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
 
 	job.Tags, err = api.JobRepository.GetTags(repository.GetUserFromContext(r.Context()), &job.ID)
 	if err != nil {
@@ -736,12 +1218,14 @@ func (api *RestApi) tagJob(rw http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		job.Tags = append(job.Tags, &schema.Tag{
+		t := &schema.Tag{
 			ID:    tagId,
 			Type:  tag.Type,
 			Name:  tag.Name,
 			Scope: tag.Scope,
-		})
+		}
+		job.Tags = append(job.Tags, t)
+		webhook.FireJobTagged(job, t)
 	}
 
 	rw.Header().Add("Content-Type", "application/json")
@@ -749,73 +1233,226 @@ func (api *RestApi) tagJob(rw http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(rw).Encode(job)
 }
 
-// startJob godoc
-// @summary     Adds a new job as "running"
+// importJob godoc
+// @summary     Imports a finished job
 // @tags Job add and modify
-// @description Job specified in request body will be saved to database as "running" with new DB ID.
-// @description Job specifications follow the 'JobMeta' scheme, API will fail to execute if requirements are not met.
-// @accept      json
+// @description Imports a job with its metric data into the job archive and the database, like the -import-job command line flag.
+// @description The meta.json and the data.json of the job are either sent as parts "meta" and "data" of a multipart form or combined as JSON body.
+// @description Both, the body and the parts, can be gzip compressed. They are validated against the JSON schemas if validation is enabled.
+// @description The body and the decompressed parts are limited by the 'import-max-size' configuration.
+// @accept      json,mpfd
 // @produce     json
-// @param       request body     schema.JobMeta          true "Job to add"
-// @success     201     {object} api.StartJobApiResponse      "Job added successfully"
+// @param       request body     api.ImportJobApiRequest  true "Job to import"
+// @success     201     {object} api.ImportJobApiResponse     "Job imported successfully"
 // @failure     400     {object} api.ErrorResponse            "Bad Request"
 // @failure     401     {object} api.ErrorResponse            "Unauthorized"
 // @failure     403     {object} api.ErrorResponse            "Forbidden"
+// @failure     413     {object} api.ErrorResponse            "Request Entity Too Large: The job exceeds the import limit"
 // @failure     422     {object} api.ErrorResponse            "Unprocessable Entity: The combination of jobId, clusterId and startTime does already exist"
 // @failure     500     {object} api.ErrorResponse            "Internal Server Error"
 // @security    ApiKeyAuth
-// @router      /jobs/start_job/ [post]
-func (api *RestApi) startJob(rw http.ResponseWriter, r *http.Request) {
-	req := schema.JobMeta{BaseJob: schema.JobDefaults}
-	if err := decode(r.Body, &req); err != nil {
+// @router      /jobs/import/ [post]
+func (api *RestApi) importJob(rw http.ResponseWriter, r *http.Request) {
+	limit := int64(config.Keys.ImportMaxSize) * 1024 * 1024
+	r.Body = http.MaxBytesReader(rw, r.Body, limit)
+	rawMeta, rawData, err := readImportRequest(r, limit)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) || errors.Is(err, errImportTooLarge) {
+			handleError(fmt.Errorf("job exceeds the import limit of %d MB", config.Keys.ImportMaxSize), http.StatusRequestEntityTooLarge, rw)
+			return
+		}
 		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
 		return
 	}
 
-	if req.State == "" {
-		req.State = schema.JobStateRunning
-	}
-	if err := importer.SanityChecks(&req.BaseJob); err != nil {
+	job, jobData, err := importer.DecodeJob(rawMeta, rawData, config.Keys.Validate)
+	if err != nil {
 		handleError(err, http.StatusBadRequest, rw)
 		return
 	}
+	if job.State == schema.JobStateRunning {
+		handleError(errors.New("only finished jobs can be imported"), http.StatusBadRequest, rw)
+		return
+	}
+	if err := checkTokenCluster(r, job.Cluster); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
 
 	// aquire lock to avoid race condition between API calls
-	var unlockOnce sync.Once
 	api.RepositoryMutex.Lock()
-	defer unlockOnce.Do(api.RepositoryMutex.Unlock)
+	defer api.RepositoryMutex.Unlock()
 
-	// Check if combination of (job_id, cluster_id, start_time) already exists:
-	jobs, err := api.JobRepository.FindAll(&req.JobID, &req.Cluster, nil)
-	if err != nil && err != sql.ErrNoRows {
+	if jobs, err := api.JobRepository.FindAllWithDeleted(&job.JobID, &job.Cluster, &job.StartTime); err != nil {
 		handleError(fmt.Errorf("checking for duplicate failed: %w", err), http.StatusInternalServerError, rw)
 		return
-	} else if err == nil {
-		for _, job := range jobs {
-			if (req.StartTime - job.StartTimeUnix) < 86400 {
-				handleError(fmt.Errorf("a job with that jobId, cluster and startTime already exists: dbid: %d, jobid: %d", job.ID, job.JobID), http.StatusUnprocessableEntity, rw)
-				return
-			}
-		}
+	} else if len(jobs) > 0 {
+		handleError(fmt.Errorf("a job with that jobId, cluster and startTime already exists: jobid: %d", job.JobID), http.StatusUnprocessableEntity, rw)
+		return
 	}
 
-	id, err := api.JobRepository.Start(&req)
+	id, err := importer.ImportJob(job, jobData)
 	if err != nil {
-		handleError(fmt.Errorf("insert into database failed: %w", err), http.StatusInternalServerError, rw)
+		handleError(fmt.Errorf("importing job failed: %w", err), http.StatusInternalServerError, rw)
 		return
 	}
-	// unlock here, adding Tags can be async
-	unlockOnce.Do(api.RepositoryMutex.Unlock)
-
-	for _, tag := range req.Tags {
-		if _, err := api.JobRepository.AddTagOrCreate(repository.GetUserFromContext(r.Context()), id, tag.Type, tag.Name, tag.Scope); err != nil {
-			http.Error(rw, err.Error(), http.StatusInternalServerError)
-			handleError(fmt.Errorf("adding tag to new job %d failed: %w", id, err), http.StatusInternalServerError, rw)
-			return
-		}
-	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	json.NewEncoder(rw).Encode(ImportJobApiResponse{
+		DBID:    id,
+		Message: "success",
+	})
+}
+
+// Read the meta.json and the data.json of a job to import from a multipart
+// form or a combined JSON body. Each of them is limited to `limit` bytes
+// after decompression.
+func readImportRequest(r *http.Request, limit int64) (rawMeta, rawData []byte, err error) {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		// The form is parsed already if the request was recorded in the audit log
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return nil, nil, err
+		}
+		if rawMeta, err = readFormPart(r.MultipartForm, "meta", limit); err != nil {
+			return nil, nil, err
+		}
+		if rawData, err = readFormPart(r.MultipartForm, "data", limit); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		body, err := readMaybeGzip(r.Body, limit)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var req ImportJobApiRequest
+		if err := decode(bytes.NewReader(body), &req); err != nil {
+			return nil, nil, err
+		}
+		rawMeta, rawData = req.Meta, req.Data
+	}
+
+	if len(rawMeta) == 0 || len(rawData) == 0 {
+		return nil, nil, errors.New("the job meta data and the job data are required")
+	}
+	return rawMeta, rawData, nil
+}
+
+// Read the form part `name`, which is either a file or a value.
+func readFormPart(form *multipart.Form, name string, limit int64) ([]byte, error) {
+	if files := form.File[name]; len(files) > 0 {
+		f, err := files[0].Open()
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return readMaybeGzip(f, limit)
+	}
+	if values := form.Value[name]; len(values) > 0 {
+		return readMaybeGzip(strings.NewReader(values[0]), limit)
+	}
+
+	return nil, nil
+}
+
+var errImportTooLarge = errors.New("import exceeds the size limit")
+
+// Read all of r, which is decompressed if it starts like gzip data. Fails
+// with errImportTooLarge if there are more than `limit` bytes.
+func readMaybeGzip(r io.Reader, limit int64) ([]byte, error) {
+	var src io.Reader = bufio.NewReader(r)
+	if magic, err := src.(*bufio.Reader).Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		zr, err := gzip.NewReader(src)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		src = zr
+	}
+
+	b, err := io.ReadAll(io.LimitReader(src, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > limit {
+		return nil, errImportTooLarge
+	}
+	return b, nil
+}
+
+// startJob godoc
+// @summary     Adds a new job as "running"
+// @tags Job add and modify
+// @description Job specified in request body will be saved to database as "running" with new DB ID.
+// @description Job specifications follow the 'JobMeta' scheme, API will fail to execute if requirements are not met.
+// @accept      json
+// @produce     json
+// @param       request body     schema.JobMeta          true "Job to add"
+// @success     201     {object} api.StartJobApiResponse      "Job added successfully"
+// @failure     400     {object} api.ErrorResponse            "Bad Request"
+// @failure     401     {object} api.ErrorResponse            "Unauthorized"
+// @failure     403     {object} api.ErrorResponse            "Forbidden"
+// @failure     422     {object} api.ErrorResponse            "Unprocessable Entity: The combination of jobId, clusterId and startTime does already exist"
+// @failure     500     {object} api.ErrorResponse            "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /jobs/start_job/ [post]
+func (api *RestApi) startJob(rw http.ResponseWriter, r *http.Request) {
+	req := schema.JobMeta{BaseJob: schema.JobDefaults}
+	if err := decode(r.Body, &req); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	if req.State == "" {
+		req.State = schema.JobStateRunning
+	}
+	if err := importer.SanityChecks(&req.BaseJob); err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+	if err := checkQueueTimes(&req); err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+	if err := checkTokenCluster(r, req.Cluster); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+
+	// aquire lock to avoid race condition between API calls
+	var unlockOnce sync.Once
+	api.RepositoryMutex.Lock()
+	defer unlockOnce.Do(api.RepositoryMutex.Unlock)
+
+	// Check if combination of (job_id, cluster_id, start_time) already exists:
+	if status, err := api.checkDuplicateJob(&req); err != nil {
+		handleError(err, status, rw)
+		return
+	}
+
+	id, err := api.JobRepository.Start(repository.GetUserFromContext(r.Context()), &req)
+	if err != nil {
+		handleError(fmt.Errorf("insert into database failed: %w", err), http.StatusInternalServerError, rw)
+		return
+	}
+	// unlock here, adding Tags can be async
+	unlockOnce.Do(api.RepositoryMutex.Unlock)
+
+	for _, tag := range req.Tags {
+		if tag.ID, err = api.JobRepository.AddTagOrCreate(repository.GetUserFromContext(r.Context()), id, tag.Type, tag.Name, tag.Scope); err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			handleError(fmt.Errorf("adding tag to new job %d failed: %w", id, err), http.StatusInternalServerError, rw)
+			return
+		}
+	}
 
 	log.Printf("new job (id: %d): cluster=%s, jobId=%d, user=%s, startTime=%d", id, req.Cluster, req.JobID, req.User, req.StartTime)
+	webhook.FireJobStarted(id, &req)
+	for _, tag := range req.Tags {
+		webhook.FireStartedJobTagged(id, &req, tag)
+	}
 	rw.Header().Add("Content-Type", "application/json")
 	rw.WriteHeader(http.StatusCreated)
 	json.NewEncoder(rw).Encode(DefaultJobApiResponse{
@@ -823,6 +1460,38 @@ func (api *RestApi) startJob(rw http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// A job is a duplicate if a job with the same jobId on the same cluster
+// started less than a day before. Returns the HTTP status for the error.
+func (api *RestApi) checkDuplicateJob(req *schema.JobMeta) (int, error) {
+	// Deleted jobs can be restored, they count as duplicates as well
+	jobs, err := api.JobRepository.FindAllWithDeleted(&req.JobID, &req.Cluster, nil)
+	if err != nil && err != sql.ErrNoRows {
+		return http.StatusInternalServerError, fmt.Errorf("checking for duplicate failed: %w", err)
+	} else if err == nil {
+		for _, job := range jobs {
+			if (req.StartTime - job.StartTimeUnix) < 86400 {
+				return http.StatusUnprocessableEntity, fmt.Errorf("a job with that jobId, cluster and startTime already exists: dbid: %d, jobid: %d", job.ID, job.JobID)
+			}
+		}
+	}
+	return 0, nil
+}
+
+// The submit and the eligible time are optional, but have to be consistent
+// with the start time if they are set.
+func checkQueueTimes(job *schema.JobMeta) error {
+	if job.SubmitTime < 0 || job.EligibleTime < 0 {
+		return fmt.Errorf("submit time and eligible time must not be negative")
+	}
+	if job.SubmitTime > job.StartTime {
+		return fmt.Errorf("submit time %d is after start time %d", job.SubmitTime, job.StartTime)
+	}
+	if job.EligibleTime != 0 && (job.EligibleTime < job.SubmitTime || job.EligibleTime > job.StartTime) {
+		return fmt.Errorf("eligible time %d is not between submit time %d and start time %d", job.EligibleTime, job.SubmitTime, job.StartTime)
+	}
+	return nil
+}
+
 // stopJobByRequest godoc
 // @summary     Marks job as completed and triggers archiving
 // @tags Job add and modify
@@ -861,14 +1530,268 @@ func (api *RestApi) stopJobByRequest(rw http.ResponseWriter, r *http.Request) {
 		handleError(fmt.Errorf("finding job failed: %w", err), http.StatusUnprocessableEntity, rw)
 		return
 	}
+	if err := checkTokenCluster(r, job.Cluster); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+
+	api.checkAndHandleStopJob(rw, repository.GetUserFromContext(r.Context()), job, req)
+}
+
+// Upper limit for the number of jobs in one bulk request
+const maxBulkJobs = 1000
+
+// startJobs godoc
+// @summary     Adds a batch of new jobs as "running"
+// @tags Job add and modify
+// @description Jobs specified in the request body array will be saved to the database as "running" in a single transaction.
+// @description Each job is checked like in /jobs/start_job/, a failed check only rejects that job. The response holds one result per job in request order.
+// @description At most 1000 jobs can be started with one request.
+// @accept      json
+// @produce     json
+// @param       request body     []schema.JobMeta         true "Jobs to add"
+// @success     200     {object} api.BulkJobApiResponse       "Results per job"
+// @failure     400     {object} api.ErrorResponse            "Bad Request"
+// @failure     401     {object} api.ErrorResponse            "Unauthorized"
+// @failure     403     {object} api.ErrorResponse            "Forbidden"
+// @failure     500     {object} api.ErrorResponse            "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /jobs/start_jobs/ [post]
+func (api *RestApi) startJobs(rw http.ResponseWriter, r *http.Request) {
+	var reqs []json.RawMessage
+	if err := decode(r.Body, &reqs); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+	if len(reqs) > maxBulkJobs {
+		handleError(fmt.Errorf("too many jobs (%d), the maximum is %d", len(reqs), maxBulkJobs), http.StatusBadRequest, rw)
+		return
+	}
+
+	results := make([]BulkJobApiResult, len(reqs))
+	jobs := make([]*schema.JobMeta, len(reqs))
+	for i, raw := range reqs {
+		job := &schema.JobMeta{BaseJob: schema.JobDefaults}
+		if err := decode(bytes.NewReader(raw), job); err != nil {
+			results[i] = BulkJobApiResult{Status: http.StatusBadRequest, Error: fmt.Sprintf("parsing job failed: %s", err.Error())}
+			continue
+		}
+		if job.State == "" {
+			job.State = schema.JobStateRunning
+		}
+		if err := importer.SanityChecks(&job.BaseJob); err != nil {
+			results[i] = BulkJobApiResult{Status: http.StatusBadRequest, Error: err.Error()}
+			continue
+		}
+		if err := checkQueueTimes(job); err != nil {
+			results[i] = BulkJobApiResult{Status: http.StatusBadRequest, Error: err.Error()}
+			continue
+		}
+		if err := checkTokenCluster(r, job.Cluster); err != nil {
+			results[i] = BulkJobApiResult{Status: http.StatusForbidden, Error: err.Error()}
+			continue
+		}
+		jobs[i] = job
+	}
+
+	user := repository.GetUserFromContext(r.Context())
+
+	// aquire lock to avoid race condition between API calls
+	var unlockOnce sync.Once
+	api.RepositoryMutex.Lock()
+	defer unlockOnce.Do(api.RepositoryMutex.Unlock)
+
+	// Jobs of this request are not in the database yet
+	started := make(map[string][]*schema.JobMeta)
+	for i, job := range jobs {
+		if job == nil {
+			continue
+		}
+		if status, err := api.checkDuplicateJob(job); err != nil {
+			results[i], jobs[i] = BulkJobApiResult{Status: status, Error: err.Error()}, nil
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%d", job.Cluster, job.JobID)
+		for _, other := range started[key] {
+			if (job.StartTime - other.StartTime) < 86400 {
+				results[i], jobs[i] = BulkJobApiResult{Status: http.StatusUnprocessableEntity, Error: fmt.Sprintf("a job with that jobId, cluster and startTime is already part of the request: jobid: %d", job.JobID)}, nil
+				break
+			}
+		}
+		if jobs[i] != nil {
+			started[key] = append(started[key], job)
+		}
+	}
+
+	t, err := api.JobRepository.TransactionInit()
+	if err != nil {
+		handleError(fmt.Errorf("starting transaction failed: %w", err), http.StatusInternalServerError, rw)
+		return
+	}
+	for i, job := range jobs {
+		if job == nil {
+			continue
+		}
+		id, err := api.JobRepository.TransactionStart(t, user, job)
+		if err != nil {
+			api.JobRepository.TransactionRollback(t)
+			handleError(fmt.Errorf("insert of job %d into database failed: %w", job.JobID, err), http.StatusInternalServerError, rw)
+			return
+		}
+		results[i] = BulkJobApiResult{Status: http.StatusCreated, DBID: id}
+	}
+	if err := api.JobRepository.TransactionEnd(t); err != nil {
+		handleError(fmt.Errorf("insert into database failed: %w", err), http.StatusInternalServerError, rw)
+		return
+	}
+	// unlock here, adding Tags can be async
+	unlockOnce.Do(api.RepositoryMutex.Unlock)
+
+	for i, job := range jobs {
+		if job == nil {
+			continue
+		}
+		id := results[i].DBID
+		tagged := make([]*schema.Tag, 0, len(job.Tags))
+		for _, tag := range job.Tags {
+			tagId, err := api.JobRepository.AddTagOrCreate(user, id, tag.Type, tag.Name, tag.Scope)
+			if err != nil {
+				results[i].Status = http.StatusInternalServerError
+				results[i].Error = fmt.Sprintf("adding tag to new job %d failed: %s", id, err.Error())
+				break
+			}
+			tag.ID = tagId
+			tagged = append(tagged, tag)
+		}
+		log.Printf("new job (id: %d): cluster=%s, jobId=%d, user=%s, startTime=%d", id, job.Cluster, job.JobID, job.User, job.StartTime)
+		webhook.FireJobStarted(id, job)
+		for _, tag := range tagged {
+			webhook.FireStartedJobTagged(id, job, tag)
+		}
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(BulkJobApiResponse{Results: results})
+}
+
+// stopJobs godoc
+// @summary     Marks a batch of jobs as completed and triggers archiving
+// @tags Job add and modify
+// @description Jobs to stop are specified like in /jobs/stop_job/ and updated in a single transaction.
+// @description A job that cannot be found or stopped only rejects that request. The response holds one result per request in request order.
+// @description At most 1000 jobs can be stopped with one request.
+// @accept      json
+// @produce     json
+// @param       request body     []api.StopJobApiRequest true "Jobs to stop"
+// @success     200     {object} api.BulkJobApiResponse       "Results per job"
+// @failure     400     {object} api.ErrorResponse            "Bad Request"
+// @failure     401     {object} api.ErrorResponse            "Unauthorized"
+// @failure     403     {object} api.ErrorResponse            "Forbidden"
+// @failure     500     {object} api.ErrorResponse            "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /jobs/stop_jobs/ [post]
+func (api *RestApi) stopJobs(rw http.ResponseWriter, r *http.Request) {
+	var reqs []StopJobApiRequest
+	if err := decode(r.Body, &reqs); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+	if len(reqs) > maxBulkJobs {
+		handleError(fmt.Errorf("too many jobs (%d), the maximum is %d", len(reqs), maxBulkJobs), http.StatusBadRequest, rw)
+		return
+	}
 
-	api.checkAndHandleStopJob(rw, job, req)
+	user := repository.GetUserFromContext(r.Context())
+	results := make([]BulkJobApiResult, len(reqs))
+	jobs := make([]*schema.Job, len(reqs))
+
+	var unlockOnce sync.Once
+	api.RepositoryMutex.Lock()
+	defer unlockOnce.Do(api.RepositoryMutex.Unlock)
+
+	stopped := make(map[int64]bool, len(reqs))
+	for i := range reqs {
+		req := &reqs[i]
+		if req.JobId == nil {
+			results[i] = BulkJobApiResult{Status: http.StatusBadRequest, Error: "the field 'jobId' is required"}
+			continue
+		}
+
+		job, err := api.JobRepository.Find(req.JobId, req.Cluster, req.StartTime)
+		if err != nil {
+			results[i] = BulkJobApiResult{Status: http.StatusUnprocessableEntity, Error: fmt.Sprintf("finding job failed: %s", err.Error())}
+			continue
+		}
+		if err := checkTokenCluster(r, job.Cluster); err != nil {
+			results[i] = BulkJobApiResult{Status: http.StatusForbidden, DBID: job.ID, Error: err.Error()}
+			continue
+		}
+		if stopped[job.ID] {
+			results[i] = BulkJobApiResult{Status: http.StatusBadRequest, DBID: job.ID, Error: fmt.Sprintf("jobId %d (id %d) on %s : job is stopped twice in the request", job.JobID, job.ID, job.Cluster)}
+			continue
+		}
+		if err := checkStopJob(job, req); err != nil {
+			results[i] = BulkJobApiResult{Status: http.StatusBadRequest, DBID: job.ID, Error: err.Error()}
+			continue
+		}
+
+		job.Duration = int32(req.StopTime - job.StartTime.Unix())
+		job.State = req.State
+		jobs[i], stopped[job.ID] = job, true
+	}
+
+	// Mark jobs as stopped in the database (update state and duration)
+	t, err := api.JobRepository.TransactionInit()
+	if err != nil {
+		handleError(fmt.Errorf("starting transaction failed: %w", err), http.StatusInternalServerError, rw)
+		return
+	}
+	for i, job := range jobs {
+		if job == nil {
+			continue
+		}
+		if err := api.JobRepository.TransactionStop(t, user, job.ID, job.Duration, job.State, job.MonitoringStatus); err != nil {
+			api.JobRepository.TransactionRollback(t)
+			handleError(fmt.Errorf("jobId %d (id %d) on %s : marking job as '%s' (duration: %d) in DB failed: %w", job.JobID, job.ID, job.Cluster, job.State, job.Duration, err), http.StatusInternalServerError, rw)
+			return
+		}
+		results[i] = BulkJobApiResult{Status: http.StatusOK, DBID: job.ID}
+	}
+	if err := api.JobRepository.TransactionEnd(t); err != nil {
+		handleError(fmt.Errorf("marking jobs as stopped in DB failed: %w", err), http.StatusInternalServerError, rw)
+		return
+	}
+	unlockOnce.Do(api.RepositoryMutex.Unlock)
+
+	for _, job := range jobs {
+		if job != nil {
+			webhook.FireJobEvent(schema.WebhookEventJobStopped, job)
+		}
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(BulkJobApiResponse{Results: results})
+
+	// Trigger async archiving, as in checkAndHandleStopJob errors from here on
+	// can not be communicated to the client
+	for _, job := range jobs {
+		if job == nil || job.MonitoringStatus == schema.MonitoringStatusDisabled {
+			continue
+		}
+		log.Printf("archiving job... (dbid: %d): cluster=%s, jobId=%d, user=%s, startTime=%s, duration=%d, state=%s", job.ID, job.Cluster, job.JobID, job.User, job.StartTime, job.Duration, job.State)
+		archiver.TriggerArchiving(job)
+	}
 }
 
 // deleteJobById godoc
 // @summary     Remove a job from the sql database
 // @tags Job remove
-// @description Job to remove is specified by database ID. This will not remove the job from the job archive.
+// @description Job to remove is specified by database ID. The job is marked as deleted and hidden from all queries.
+// @description Running jobs cannot be deleted.
+// @description Admins can restore it until it is purged from the database and the job archive after the grace period.
 // @produce     json
 // @param       id      path     int                   true "Database ID of Job"
 // @success     200     {object} api.DeleteJobApiResponse     "Success message"
@@ -881,6 +1804,11 @@ func (api *RestApi) stopJobByRequest(rw http.ResponseWriter, r *http.Request) {
 // @security    ApiKeyAuth
 // @router      /jobs/delete_job/{id} [delete]
 func (api *RestApi) deleteJobById(rw http.ResponseWriter, r *http.Request) {
+	if err := checkTokenCluster(r, ""); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+
 	// Fetch job (that will be stopped) from db
 	id, ok := mux.Vars(r)["id"]
 	var err error
@@ -891,7 +1819,7 @@ func (api *RestApi) deleteJobById(rw http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		err = api.JobRepository.DeleteJobById(id)
+		err = api.JobRepository.DeleteJobById(id, repository.GetUserFromContext(r.Context()))
 	} else {
 		handleError(errors.New("the parameter 'id' is required"), http.StatusBadRequest, rw)
 		return
@@ -911,6 +1839,7 @@ func (api *RestApi) deleteJobById(rw http.ResponseWriter, r *http.Request) {
 // @summary     Remove a job from the sql database
 // @tags Job remove
 // @description Job to delete is specified by request body. All fields are required in this case.
+// @description The job is marked as deleted and purged after the grace period. Running jobs cannot be deleted.
 // @accept      json
 // @produce     json
 // @param       request body     api.DeleteJobApiRequest true "All fields required"
@@ -944,8 +1873,12 @@ func (api *RestApi) deleteJobByRequest(rw http.ResponseWriter, r *http.Request)
 		handleError(fmt.Errorf("finding job failed: %w", err), http.StatusUnprocessableEntity, rw)
 		return
 	}
+	if err := checkTokenCluster(r, job.Cluster); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
 
-	err = api.JobRepository.DeleteJobById(job.ID)
+	err = api.JobRepository.DeleteJobById(job.ID, repository.GetUserFromContext(r.Context()))
 	if err != nil {
 		handleError(fmt.Errorf("deleting job failed: %w", err), http.StatusUnprocessableEntity, rw)
 		return
@@ -961,7 +1894,8 @@ func (api *RestApi) deleteJobByRequest(rw http.ResponseWriter, r *http.Request)
 // deleteJobBefore godoc
 // @summary     Remove a job from the sql database
 // @tags Job remove
-// @description Remove all jobs with start time before timestamp. The jobs will not be removed from the job archive.
+// @description Remove all jobs with start time before timestamp. The jobs are marked as deleted and purged
+// @description from the database and the job archive after the grace period. Running jobs are skipped.
 // @produce     json
 // @param       ts      path     int                   true "Unix epoch timestamp"
 // @success     200     {object} api.DeleteJobApiResponse     "Success message"
@@ -974,6 +1908,11 @@ func (api *RestApi) deleteJobByRequest(rw http.ResponseWriter, r *http.Request)
 // @security    ApiKeyAuth
 // @router      /jobs/delete_job_before/{ts} [delete]
 func (api *RestApi) deleteJobBefore(rw http.ResponseWriter, r *http.Request) {
+	if err := checkTokenCluster(r, ""); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+
 	var cnt int
 	// Fetch job (that will be stopped) from db
 	id, ok := mux.Vars(r)["ts"]
@@ -985,7 +1924,7 @@ func (api *RestApi) deleteJobBefore(rw http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		cnt, err = api.JobRepository.DeleteJobsBefore(ts)
+		cnt, err = api.JobRepository.DeleteJobsBefore(ts, repository.GetUserFromContext(r.Context()))
 	} else {
 		handleError(errors.New("the parameter 'ts' is required"), http.StatusBadRequest, rw)
 		return
@@ -1002,87 +1941,678 @@ func (api *RestApi) deleteJobBefore(rw http.ResponseWriter, r *http.Request) {
 	})
 }
 
-func (api *RestApi) checkAndHandleStopJob(rw http.ResponseWriter, job *schema.Job, req StopJobApiRequest) {
-	// Sanity checks
-	if job == nil || job.StartTime.Unix() >= req.StopTime || job.State != schema.JobStateRunning {
-		handleError(fmt.Errorf("jobId %d (id %d) on %s : stopTime %d must be larger than startTime %d and only running jobs can be stopped (state is: %s)", job.JobID, job.ID, job.Cluster, req.StopTime, job.StartTime.Unix(), job.State), http.StatusBadRequest, rw)
+// getDeletedJobs godoc
+// @summary     Lists deleted jobs
+// @tags Job remove
+// @description Get all jobs marked as deleted that are not purged yet. Only accessible by admins.
+// @produce     json
+// @success     200     {object} api.GetDeletedJobsApiResponse "Deleted jobs"
+// @failure     401     {object} api.ErrorResponse          "Unauthorized"
+// @failure     403     {object} api.ErrorResponse          "Forbidden"
+// @failure     500     {object} api.ErrorResponse          "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /jobs/deleted/ [get]
+func (api *RestApi) getDeletedJobs(rw http.ResponseWriter, r *http.Request) {
+	if user := repository.GetUserFromContext(r.Context()); user != nil && !user.HasRole(schema.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %v", schema.GetRoleString(schema.RoleAdmin)), http.StatusForbidden, rw)
 		return
 	}
 
-	if req.State != "" && !req.State.Valid() {
-		handleError(fmt.Errorf("jobId %d (id %d) on %s : invalid requested job state: %#v", job.JobID, job.ID, job.Cluster, req.State), http.StatusBadRequest, rw)
+	jobs, err := api.JobRepository.FindDeletedJobs(0)
+	if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
 		return
-	} else if req.State == "" {
-		req.State = schema.JobStateCompleted
+	}
+	if token := repository.GetApiTokenFromContext(r.Context()); token != nil {
+		jobs = slices.DeleteFunc(jobs, func(job *repository.DeletedJob) bool { return !token.AllowsCluster(job.Cluster) })
 	}
 
-	// Mark job as stopped in the database (update state and duration)
-	job.Duration = int32(req.StopTime - job.StartTime.Unix())
-	job.State = req.State
-	if err := api.JobRepository.Stop(job.ID, job.Duration, job.State, job.MonitoringStatus); err != nil {
-		handleError(fmt.Errorf("jobId %d (id %d) on %s : marking job as '%s' (duration: %d) in DB failed: %w", job.JobID, job.ID, job.Cluster, job.State, job.Duration, err), http.StatusInternalServerError, rw)
+	rw.Header().Add("Content-Type", "application/json")
+	bw := bufio.NewWriter(rw)
+	defer bw.Flush()
+
+	if err := json.NewEncoder(bw).Encode(GetDeletedJobsApiResponse{Jobs: jobs}); err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+}
+
+// restoreJob godoc
+// @summary     Restore a deleted job
+// @tags Job remove
+// @description Job to restore is specified by database ID. Jobs can be restored until they are purged after the grace period.
+// @description Jobs cannot be restored while a job with the same jobId and cluster that started within a day exists.
+// @description Only accessible by admins.
+// @produce     json
+// @param       id      path     int                   true "Database ID of Job"
+// @success     200     {object} api.DefaultJobApiResponse  "Success message"
+// @failure     400     {object} api.ErrorResponse          "Bad Request"
+// @failure     401     {object} api.ErrorResponse          "Unauthorized"
+// @failure     403     {object} api.ErrorResponse          "Forbidden"
+// @failure     422     {object} api.ErrorResponse          "Unprocessable Entity: restoring job failed: sql: no rows in result set"
+// @failure     500     {object} api.ErrorResponse          "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /jobs/restore_job/{id} [post]
+func (api *RestApi) restoreJob(rw http.ResponseWriter, r *http.Request) {
+	user := repository.GetUserFromContext(r.Context())
+	if user != nil && !user.HasRole(schema.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %v", schema.GetRoleString(schema.RoleAdmin)), http.StatusForbidden, rw)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		handleError(fmt.Errorf("integer expected in path for id: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	if err := checkTokenCluster(r, ""); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+
+	if err := api.JobRepository.RestoreJob(id, user); err != nil {
+		handleError(fmt.Errorf("restoring job failed: %w", err), http.StatusUnprocessableEntity, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(DefaultJobApiResponse{
+		Message: fmt.Sprintf("Successfully restored job %d", id),
+	})
+}
+
+func (api *RestApi) checkAndHandleStopJob(rw http.ResponseWriter, user *schema.User, job *schema.Job, req StopJobApiRequest) {
+	// Sanity checks
+	if err := checkStopJob(job, &req); err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+
+	// Mark job as stopped in the database (update state and duration)
+	job.Duration = int32(req.StopTime - job.StartTime.Unix())
+	job.State = req.State
+	if err := api.JobRepository.Stop(user, job.ID, job.Duration, job.State, job.MonitoringStatus); err != nil {
+		handleError(fmt.Errorf("jobId %d (id %d) on %s : marking job as '%s' (duration: %d) in DB failed: %w", job.JobID, job.ID, job.Cluster, job.State, job.Duration, err), http.StatusInternalServerError, rw)
+		return
+	}
+
+	log.Printf("archiving job... (dbid: %d): cluster=%s, jobId=%d, user=%s, startTime=%s, duration=%d, state=%s", job.ID, job.Cluster, job.JobID, job.User, job.StartTime, job.Duration, job.State)
+	webhook.FireJobEvent(schema.WebhookEventJobStopped, job)
+
+	// Send a response (with status OK). This means that erros that happen from here on forward
+	// can *NOT* be communicated to the client. If reading from a MetricDataRepository or
+	// writing to the filesystem fails, the client will not know.
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(job)
+
+	// Monitoring is disabled...
+	if job.MonitoringStatus == schema.MonitoringStatusDisabled {
+		return
+	}
+
+	// Trigger async archiving
+	archiver.TriggerArchiving(job)
+}
+
+// checkTokenCluster fails if the request was authenticated by an API token
+// that is restricted to other clusters. Requests without a cluster
+// (e.g. deleting all jobs before a timestamp) fail for all restricted tokens.
+func checkTokenCluster(r *http.Request, cluster string) error {
+	token := repository.GetApiTokenFromContext(r.Context())
+	if token == nil || token.AllowsCluster(cluster) {
+		return nil
+	}
+	if cluster == "" {
+		return fmt.Errorf("api token '%s' is restricted to the clusters %s", token.Name, strings.Join(token.Clusters, ", "))
+	}
+	return fmt.Errorf("api token '%s' is not valid for cluster %s", token.Name, cluster)
+}
+
+// Only running jobs can be stopped and only after they started. A missing
+// state in the request defaults to completed.
+func checkStopJob(job *schema.Job, req *StopJobApiRequest) error {
+	if job.StartTime.Unix() >= req.StopTime || job.State != schema.JobStateRunning {
+		return fmt.Errorf("jobId %d (id %d) on %s : stopTime %d must be larger than startTime %d and only running jobs can be stopped (state is: %s)", job.JobID, job.ID, job.Cluster, req.StopTime, job.StartTime.Unix(), job.State)
+	}
+
+	if req.State != "" && !req.State.Valid() {
+		return fmt.Errorf("jobId %d (id %d) on %s : invalid requested job state: %#v", job.JobID, job.ID, job.Cluster, req.State)
+	} else if req.State == "" {
+		req.State = schema.JobStateCompleted
+	}
+	return nil
+}
+
+func (api *RestApi) getJobMetrics(rw http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	metrics := r.URL.Query()["metric"]
+	var scopes []schema.MetricScope
+	for _, scope := range r.URL.Query()["scope"] {
+		var s schema.MetricScope
+		if err := s.UnmarshalGQL(scope); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		scopes = append(scopes, s)
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+
+	type Respone struct {
+		Data *struct {
+			JobMetrics []*model.JobMetricWithName `json:"jobMetrics"`
+		} `json:"data"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	resolver := graph.GetResolverInstance()
+	data, err := resolver.Query().JobMetrics(r.Context(), id, metrics, scopes, nil)
+	if err != nil {
+		json.NewEncoder(rw).Encode(Respone{
+			Error: &struct {
+				Message string "json:\"message\""
+			}{Message: err.Error()},
+		})
+		return
+	}
+
+	json.NewEncoder(rw).Encode(Respone{
+		Data: &struct {
+			JobMetrics []*model.JobMetricWithName "json:\"jobMetrics\""
+		}{JobMetrics: data},
+	})
+}
+
+// getJobEvents godoc
+// @summary     Get the lifecycle of a job
+// @tags Job query
+// @description Job to get is specified by database ID
+// @description Returns all recorded events of the job: start, stop, archiving, metadata edits and tag changes.
+// @produce     json
+// @param       id          path     int                  true "Database ID of Job"
+// @success     200         {object} api.GetJobEventsApiResponse "Job events"
+// @failure     400         {object} api.ErrorResponse      "Bad Request"
+// @failure     401         {object} api.ErrorResponse      "Unauthorized"
+// @failure     403         {object} api.ErrorResponse      "Forbidden"
+// @failure     404         {object} api.ErrorResponse      "Resource not found"
+// @failure     500         {object} api.ErrorResponse      "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /jobs/events/{id} [get]
+func (api *RestApi) getJobEvents(rw http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		handleError(fmt.Errorf("integer expected in path for id: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	job, err := api.JobRepository.FindById(r.Context(), id)
+	if err != nil {
+		handleError(fmt.Errorf("finding job with db id %d failed: %w", id, err), http.StatusNotFound, rw)
+		return
+	}
+
+	events, err := api.JobRepository.GetJobEvents(job.ID)
+	if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	bw := bufio.NewWriter(rw)
+	defer bw.Flush()
+
+	if err := json.NewEncoder(bw).Encode(GetJobEventsApiResponse{Events: events}); err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+}
+
+// getAuditLog godoc
+// @summary     Lists the audit log
+// @tags Audit
+// @description Get the audit log of all mutating REST and GraphQL requests, newest first. Filters can be applied using query parameters.
+// @description Only accessible by admins. Use format=csv to export the (filtered) log.
+// @produce     json,text/csv
+// @param       user           query    string            false "Exact username"
+// @param       action         query    string            false "Substring of the action, e.g. 'delete_job'"
+// @param       target         query    string            false "Substring of the request target"
+// @param       time           query    string            false "Syntax: '$from-$to', as unix epoch timestamps in seconds"
+// @param       items-per-page query    int               false "Items per page (Default: 100, -1 for all)"
+// @param       page           query    int               false "Page Number (Default: 1)"
+// @param       format         query    string            false "Response format (Default: json)" Enums(json, csv)
+// @success     200            {object} api.GetAuditLogApiResponse "Audit log entries and page info"
+// @failure     400            {object} api.ErrorResponse       "Bad Request"
+// @failure     401            {object} api.ErrorResponse       "Unauthorized"
+// @failure     403            {object} api.ErrorResponse       "Forbidden"
+// @failure     500            {object} api.ErrorResponse       "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /audit/ [get]
+func (api *RestApi) getAuditLog(rw http.ResponseWriter, r *http.Request) {
+	if user := repository.GetUserFromContext(r.Context()); user != nil && !user.HasRole(schema.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %v", schema.GetRoleString(schema.RoleAdmin)), http.StatusForbidden, rw)
+		return
+	}
+
+	filter := &repository.AuditFilter{}
+	page := &model.PageRequest{ItemsPerPage: 100, Page: 1}
+	format := "json"
+
+	for key, vals := range r.URL.Query() {
+		switch key {
+		case "user":
+			filter.Username = vals[0]
+		case "action":
+			filter.Action = vals[0]
+		case "target":
+			filter.Target = vals[0]
+		case "time":
+			st := strings.Split(vals[0], "-")
+			if len(st) != 2 {
+				handleError(fmt.Errorf("invalid query parameter value: time"),
+					http.StatusBadRequest, rw)
+				return
+			}
+			var err error
+			if filter.From, err = strconv.ParseInt(st[0], 10, 64); err != nil {
+				handleError(err, http.StatusBadRequest, rw)
+				return
+			}
+			if filter.To, err = strconv.ParseInt(st[1], 10, 64); err != nil {
+				handleError(err, http.StatusBadRequest, rw)
+				return
+			}
+		case "page":
+			x, err := strconv.Atoi(vals[0])
+			if err != nil {
+				handleError(err, http.StatusBadRequest, rw)
+				return
+			}
+			if x < 1 {
+				handleError(fmt.Errorf("invalid query parameter value: page"), http.StatusBadRequest, rw)
+				return
+			}
+			page.Page = x
+		case "items-per-page":
+			x, err := strconv.Atoi(vals[0])
+			if err != nil {
+				handleError(err, http.StatusBadRequest, rw)
+				return
+			}
+			page.ItemsPerPage = x
+		case "format":
+			if vals[0] != "json" && vals[0] != "csv" {
+				handleError(fmt.Errorf("invalid query parameter value: format"),
+					http.StatusBadRequest, rw)
+				return
+			}
+			format = vals[0]
+		default:
+			handleError(fmt.Errorf("invalid query parameter: %s", key),
+				http.StatusBadRequest, rw)
+			return
+		}
+	}
+
+	entries, err := repository.GetAuditRepository().Query(filter, page)
+	if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	if format == "csv" {
+		rw.Header().Add("Content-Type", "text/csv")
+		rw.Header().Add("Content-Disposition", "attachment; filename=\"audit-log.csv\"")
+		cw := csv.NewWriter(rw)
+		cw.Write([]string{"id", "timeStamp", "username", "sourceIp", "authMethod", "action", "target", "payload", "status"})
+		for _, e := range entries {
+			cw.Write([]string{
+				strconv.FormatInt(e.ID, 10), strconv.FormatInt(e.TimeStamp, 10), e.Username, e.SourceIP,
+				e.AuthMethod, e.Action, e.Target, e.Payload, strconv.Itoa(e.Status),
+			})
+		}
+		cw.Flush()
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	bw := bufio.NewWriter(rw)
+	defer bw.Flush()
+
+	if err := json.NewEncoder(bw).Encode(GetAuditLogApiResponse{
+		Entries: entries,
+		Items:   page.ItemsPerPage,
+		Page:    page.Page,
+	}); err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+}
+
+// getWebhooks godoc
+// @summary     Lists all webhooks
+// @tags Webhook
+// @description Get all webhooks ordered by name. Secrets are not returned.
+// @description Only accessible by admins.
+// @produce     json
+// @success     200 {object} api.GetWebhooksApiResponse "Array of webhooks"
+// @failure     401 {object} api.ErrorResponse          "Unauthorized"
+// @failure     403 {object} api.ErrorResponse          "Forbidden"
+// @failure     500 {object} api.ErrorResponse          "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /webhooks/ [get]
+func (api *RestApi) getWebhooks(rw http.ResponseWriter, r *http.Request) {
+	if user := repository.GetUserFromContext(r.Context()); user != nil && !user.HasRole(schema.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %v", schema.GetRoleString(schema.RoleAdmin)), http.StatusForbidden, rw)
+		return
+	}
+
+	hooks, err := repository.GetWebhookRepository().List()
+	if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+	for _, h := range hooks {
+		h.Secret = ""
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	json.NewEncoder(rw).Encode(GetWebhooksApiResponse{Webhooks: hooks})
+}
+
+// createWebhook godoc
+// @summary     Registers a webhook
+// @tags Webhook
+// @description Registers an endpoint that is notified about the given events by a POST request with a JSON payload.
+// @description The payload is signed with HMAC-SHA256, the signature is sent in the header 'X-CC-Signature: sha256=<hex>'.
+// @description If no secret is given, one is generated. The secret is only returned by this request.
+// @description Failed deliveries are retried with exponential backoff. Only accessible by admins.
+// @accept      json
+// @produce     json
+// @param       request body     api.WebhookApiRequest true "Webhook to register, name, url and events are required"
+// @success     201     {object} schema.Webhook           "Registered webhook including its secret"
+// @failure     400     {object} api.ErrorResponse        "Bad Request"
+// @failure     401     {object} api.ErrorResponse        "Unauthorized"
+// @failure     403     {object} api.ErrorResponse        "Forbidden"
+// @failure     500     {object} api.ErrorResponse        "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /webhooks/ [post]
+func (api *RestApi) createWebhook(rw http.ResponseWriter, r *http.Request) {
+	user := repository.GetUserFromContext(r.Context())
+	if user != nil && !user.HasRole(schema.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %v", schema.GetRoleString(schema.RoleAdmin)), http.StatusForbidden, rw)
+		return
+	}
+
+	req := WebhookApiRequest{}
+	if err := decode(r.Body, &req); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	h := &schema.Webhook{Events: req.Events, Active: true, CreatedAt: time.Now().Unix()}
+	if req.Name != nil {
+		h.Name = *req.Name
+	}
+	if req.URL != nil {
+		h.URL = *req.URL
+	}
+	if req.Active != nil {
+		h.Active = *req.Active
+	}
+	if user != nil {
+		h.CreatedBy = user.Username
+	}
+	if req.Secret != nil && *req.Secret != "" {
+		h.Secret = *req.Secret
+	} else {
+		secret, err := webhook.GenerateSecret()
+		if err != nil {
+			handleError(err, http.StatusInternalServerError, rw)
+			return
+		}
+		h.Secret = secret
+	}
+
+	if err := repository.GetWebhookRepository().Add(h); err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+	if err := webhook.Reload(); err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	log.Printf("new webhook '%s' registered for %s", h.Name, h.URL)
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	json.NewEncoder(rw).Encode(h)
+}
+
+// updateWebhook godoc
+// @summary     Updates a webhook
+// @tags Webhook
+// @description Updates the given fields of a webhook, fields that are not set are left unchanged.
+// @description An empty secret generates a new one, which is then returned. Only accessible by admins.
+// @accept      json
+// @produce     json
+// @param       id      path     int                   true "Webhook ID"
+// @param       request body     api.WebhookApiRequest true "Fields to update"
+// @success     200     {object} schema.Webhook           "Updated webhook"
+// @failure     400     {object} api.ErrorResponse        "Bad Request"
+// @failure     401     {object} api.ErrorResponse        "Unauthorized"
+// @failure     403     {object} api.ErrorResponse        "Forbidden"
+// @failure     404     {object} api.ErrorResponse        "Webhook not found"
+// @failure     500     {object} api.ErrorResponse        "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /webhooks/{id} [post]
+func (api *RestApi) updateWebhook(rw http.ResponseWriter, r *http.Request) {
+	if user := repository.GetUserFromContext(r.Context()); user != nil && !user.HasRole(schema.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %v", schema.GetRoleString(schema.RoleAdmin)), http.StatusForbidden, rw)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		handleError(fmt.Errorf("parsing webhook id failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	req := WebhookApiRequest{}
+	if err := decode(r.Body, &req); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	repo := repository.GetWebhookRepository()
+	h, err := repo.Get(id)
+	if err == sql.ErrNoRows {
+		handleError(fmt.Errorf("webhook not found: %d", id), http.StatusNotFound, rw)
+		return
+	} else if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	if req.Name != nil {
+		h.Name = *req.Name
+	}
+	if req.URL != nil {
+		h.URL = *req.URL
+	}
+	if req.Events != nil {
+		h.Events = req.Events
+	}
+	if req.Active != nil {
+		h.Active = *req.Active
+	}
+	newSecret := false
+	if req.Secret != nil {
+		if *req.Secret == "" {
+			if h.Secret, err = webhook.GenerateSecret(); err != nil {
+				handleError(err, http.StatusInternalServerError, rw)
+				return
+			}
+			newSecret = true
+		} else {
+			h.Secret = *req.Secret
+		}
+	}
+
+	if err := repo.Update(h); err == sql.ErrNoRows {
+		handleError(fmt.Errorf("webhook not found: %d", id), http.StatusNotFound, rw)
+		return
+	} else if err != nil {
+		handleError(err, http.StatusBadRequest, rw)
+		return
+	}
+	if err := webhook.Reload(); err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	if !newSecret {
+		h.Secret = ""
+	}
+	rw.Header().Add("Content-Type", "application/json")
+	json.NewEncoder(rw).Encode(h)
+}
+
+// deleteWebhook godoc
+// @summary     Removes a webhook
+// @tags Webhook
+// @description Removes a webhook together with its delivery log. Pending deliveries are dropped.
+// @description Only accessible by admins.
+// @produce     json
+// @param       id  path     int  true "Webhook ID"
+// @success     200 {object} api.DefaultJobApiResponse "Success message"
+// @failure     400 {object} api.ErrorResponse         "Bad Request"
+// @failure     401 {object} api.ErrorResponse         "Unauthorized"
+// @failure     403 {object} api.ErrorResponse         "Forbidden"
+// @failure     404 {object} api.ErrorResponse         "Webhook not found"
+// @failure     500 {object} api.ErrorResponse         "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /webhooks/{id} [delete]
+func (api *RestApi) deleteWebhook(rw http.ResponseWriter, r *http.Request) {
+	if user := repository.GetUserFromContext(r.Context()); user != nil && !user.HasRole(schema.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %v", schema.GetRoleString(schema.RoleAdmin)), http.StatusForbidden, rw)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		handleError(fmt.Errorf("parsing webhook id failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	if err := repository.GetWebhookRepository().Delete(id); err == sql.ErrNoRows {
+		handleError(fmt.Errorf("webhook not found: %d", id), http.StatusNotFound, rw)
+		return
+	} else if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+	if err := webhook.Reload(); err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
 		return
 	}
 
-	log.Printf("archiving job... (dbid: %d): cluster=%s, jobId=%d, user=%s, startTime=%s, duration=%d, state=%s", job.ID, job.Cluster, job.JobID, job.User, job.StartTime, job.Duration, job.State)
-
-	// Send a response (with status OK). This means that erros that happen from here on forward
-	// can *NOT* be communicated to the client. If reading from a MetricDataRepository or
-	// writing to the filesystem fails, the client will not know.
 	rw.Header().Add("Content-Type", "application/json")
 	rw.WriteHeader(http.StatusOK)
-	json.NewEncoder(rw).Encode(job)
+	json.NewEncoder(rw).Encode(DefaultJobApiResponse{
+		Message: fmt.Sprintf("Successfully deleted webhook %d", id),
+	})
+}
 
-	// Monitoring is disabled...
-	if job.MonitoringStatus == schema.MonitoringStatusDisabled {
+// getWebhookDeliveries godoc
+// @summary     Lists the deliveries to a webhook
+// @tags Webhook
+// @description Get the delivery log of a webhook, newest first. Pending deliveries are included.
+// @description Finished deliveries are kept for 30 days. Only accessible by admins.
+// @produce     json
+// @param       id             path     int  true  "Webhook ID"
+// @param       items-per-page query    int  false "Items per page (Default: 100, -1 for all)"
+// @param       page           query    int  false "Page Number (Default: 1)"
+// @success     200            {object} api.GetWebhookDeliveriesApiResponse "Deliveries and page info"
+// @failure     400            {object} api.ErrorResponse                   "Bad Request"
+// @failure     401            {object} api.ErrorResponse                   "Unauthorized"
+// @failure     403            {object} api.ErrorResponse                   "Forbidden"
+// @failure     404            {object} api.ErrorResponse                   "Webhook not found"
+// @failure     500            {object} api.ErrorResponse                   "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /webhooks/{id}/deliveries [get]
+func (api *RestApi) getWebhookDeliveries(rw http.ResponseWriter, r *http.Request) {
+	if user := repository.GetUserFromContext(r.Context()); user != nil && !user.HasRole(schema.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %v", schema.GetRoleString(schema.RoleAdmin)), http.StatusForbidden, rw)
 		return
 	}
 
-	// Trigger async archiving
-	archiver.TriggerArchiving(job)
-}
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		handleError(fmt.Errorf("parsing webhook id failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
 
-func (api *RestApi) getJobMetrics(rw http.ResponseWriter, r *http.Request) {
-	id := mux.Vars(r)["id"]
-	metrics := r.URL.Query()["metric"]
-	var scopes []schema.MetricScope
-	for _, scope := range r.URL.Query()["scope"] {
-		var s schema.MetricScope
-		if err := s.UnmarshalGQL(scope); err != nil {
-			http.Error(rw, err.Error(), http.StatusBadRequest)
+	page := &model.PageRequest{ItemsPerPage: 100, Page: 1}
+	for key, vals := range r.URL.Query() {
+		switch key {
+		case "page":
+			x, err := strconv.Atoi(vals[0])
+			if err != nil {
+				handleError(err, http.StatusBadRequest, rw)
+				return
+			}
+			if x < 1 {
+				handleError(fmt.Errorf("invalid query parameter value: page"), http.StatusBadRequest, rw)
+				return
+			}
+			page.Page = x
+		case "items-per-page":
+			x, err := strconv.Atoi(vals[0])
+			if err != nil {
+				handleError(err, http.StatusBadRequest, rw)
+				return
+			}
+			page.ItemsPerPage = x
+		default:
+			handleError(fmt.Errorf("invalid query parameter: %s", key),
+				http.StatusBadRequest, rw)
 			return
 		}
-		scopes = append(scopes, s)
 	}
 
-	rw.Header().Add("Content-Type", "application/json")
-	rw.WriteHeader(http.StatusOK)
-
-	type Respone struct {
-		Data *struct {
-			JobMetrics []*model.JobMetricWithName `json:"jobMetrics"`
-		} `json:"data"`
-		Error *struct {
-			Message string `json:"message"`
-		} `json:"error"`
+	repo := repository.GetWebhookRepository()
+	if _, err := repo.Get(id); err == sql.ErrNoRows {
+		handleError(fmt.Errorf("webhook not found: %d", id), http.StatusNotFound, rw)
+		return
+	} else if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
 	}
 
-	resolver := graph.GetResolverInstance()
-	data, err := resolver.Query().JobMetrics(r.Context(), id, metrics, scopes, nil)
+	deliveries, err := repo.ListDeliveries(id, page)
 	if err != nil {
-		json.NewEncoder(rw).Encode(Respone{
-			Error: &struct {
-				Message string "json:\"message\""
-			}{Message: err.Error()},
-		})
+		handleError(err, http.StatusInternalServerError, rw)
 		return
 	}
 
-	json.NewEncoder(rw).Encode(Respone{
-		Data: &struct {
-			JobMetrics []*model.JobMetricWithName "json:\"jobMetrics\""
-		}{JobMetrics: data},
-	})
+	rw.Header().Add("Content-Type", "application/json")
+	bw := bufio.NewWriter(rw)
+	defer bw.Flush()
+
+	if err := json.NewEncoder(bw).Encode(GetWebhookDeliveriesApiResponse{
+		Deliveries: deliveries,
+		Items:      page.ItemsPerPage,
+		Page:       page.Page,
+	}); err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
 }
 
 // createUser godoc
@@ -1393,6 +2923,200 @@ func (api *RestApi) getJWT(rw http.ResponseWriter, r *http.Request) {
 	rw.Write([]byte(jwt))
 }
 
+// getApiTokens godoc
+// @summary     Lists API tokens
+// @tags Token
+// @description Get the API tokens of the requesting user. Admins get the tokens of all users or, with the user parameter, of one user.
+// @description The tokens themselves are only returned on creation.
+// @produce     json
+// @param       user query    string false "Username"
+// @success     200  {object} api.GetApiTokensApiResponse "Array of tokens"
+// @failure     401  {object} api.ErrorResponse           "Unauthorized"
+// @failure     403  {object} api.ErrorResponse           "Forbidden"
+// @failure     500  {object} api.ErrorResponse           "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /tokens/ [get]
+func (api *RestApi) getApiTokens(rw http.ResponseWriter, r *http.Request) {
+	me := repository.GetUserFromContext(r.Context())
+	username := r.URL.Query().Get("user")
+	if me != nil && !me.HasRole(schema.RoleAdmin) {
+		if username != "" && username != me.Username {
+			handleError(errors.New("only admins are allowed to list the api tokens of others"), http.StatusForbidden, rw)
+			return
+		}
+		username = me.Username
+	}
+
+	tokens, err := repository.GetApiTokenRepository().List(username)
+	if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	json.NewEncoder(rw).Encode(GetApiTokensApiResponse{Tokens: tokens})
+}
+
+// createApiToken godoc
+// @summary     Creates an API token
+// @tags Token
+// @description Creates a named API token for the REST API. The token has the roles of its user at the time of each request,
+// @description the scopes and clusters restrict it further: 'read' allows requests that do not change anything,
+// @description 'job_control' starting, stopping and updating jobs, 'job_edit' tagging, editing, importing, deleting and restoring jobs,
+// @description 'node_state' updating node and machine states and 'all' everything the user is allowed to do.
+// @description The cluster restriction applies to requests changing jobs and nodes and limits the jobs the token can read.
+// @description The token is only returned by this request, it is sent like a JWT. Only admins can create tokens for others.
+// @description A request authenticated by an API token can only create tokens with a subset of its scopes and clusters that expire no later.
+// @accept      json
+// @produce     json
+// @param       request body     api.CreateApiTokenApiRequest true "Token to create"
+// @success     201     {object} schema.ApiToken                 "Created token including the token itself"
+// @failure     400     {object} api.ErrorResponse               "Bad Request"
+// @failure     401     {object} api.ErrorResponse               "Unauthorized"
+// @failure     403     {object} api.ErrorResponse               "Forbidden"
+// @failure     422     {object} api.ErrorResponse               "Unprocessable Entity: user does not exist"
+// @failure     500     {object} api.ErrorResponse               "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /tokens/ [post]
+func (api *RestApi) createApiToken(rw http.ResponseWriter, r *http.Request) {
+	req := CreateApiTokenApiRequest{}
+	if err := decode(r.Body, &req); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	me := repository.GetUserFromContext(r.Context())
+	if req.Username == "" && me != nil {
+		req.Username = me.Username
+	}
+	if me != nil && req.Username != me.Username && !me.HasRole(schema.RoleAdmin) {
+		handleError(errors.New("only admins are allowed to create api tokens for others"), http.StatusForbidden, rw)
+		return
+	}
+	if _, err := repository.GetUserRepository().GetUser(req.Username); err != nil {
+		handleError(fmt.Errorf("unknown user: %#v", req.Username), http.StatusUnprocessableEntity, rw)
+		return
+	}
+
+	for _, cluster := range req.Clusters {
+		if archive.GetCluster(cluster) == nil {
+			handleError(fmt.Errorf("unknown cluster: %s", cluster), http.StatusBadRequest, rw)
+			return
+		}
+	}
+	now := time.Now().Unix()
+	if req.ExpiresAt != 0 && req.ExpiresAt <= now {
+		handleError(errors.New("'expiresAt' has to be in the future"), http.StatusBadRequest, rw)
+		return
+	}
+	if err := checkTokenRestrictions(r, &req); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+
+	token := &schema.ApiToken{
+		Name:      req.Name,
+		Username:  req.Username,
+		Scopes:    req.Scopes,
+		Clusters:  req.Clusters,
+		CreatedAt: now,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if token.Clusters == nil {
+		token.Clusters = make([]string, 0)
+	}
+	if err := repository.GetApiTokenRepository().Add(token); err != nil {
+		handleError(fmt.Errorf("creating api token failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	log.Infof("new api token '%s' for user '%s' (scopes: %v, clusters: %v)", token.Name, token.Username, token.Scopes, token.Clusters)
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	json.NewEncoder(rw).Encode(token)
+}
+
+// checkTokenRestrictions fails if the request was authenticated by an API
+// token and the new token would not be restricted at least as much: it may
+// only have scopes and clusters of the token and must not expire later.
+func checkTokenRestrictions(r *http.Request, req *CreateApiTokenApiRequest) error {
+	token := repository.GetApiTokenFromContext(r.Context())
+	if token == nil {
+		return nil
+	}
+	for _, scope := range req.Scopes {
+		if !token.HasScope(scope) {
+			return fmt.Errorf("api token '%s' does not have the scope %s", token.Name, scope)
+		}
+	}
+	if len(token.Clusters) != 0 {
+		if len(req.Clusters) == 0 {
+			return fmt.Errorf("api token '%s' is restricted to the clusters %s", token.Name, strings.Join(token.Clusters, ", "))
+		}
+		for _, cluster := range req.Clusters {
+			if !token.AllowsCluster(cluster) {
+				return fmt.Errorf("api token '%s' is not valid for cluster %s", token.Name, cluster)
+			}
+		}
+	}
+	if token.ExpiresAt != 0 && (req.ExpiresAt == 0 || req.ExpiresAt > token.ExpiresAt) {
+		return fmt.Errorf("api token '%s' expires at %d, the new token cannot expire later", token.Name, token.ExpiresAt)
+	}
+	return nil
+}
+
+// deleteApiToken godoc
+// @summary     Revokes an API token
+// @tags Token
+// @description Revokes an API token of the requesting user, admins can revoke all tokens.
+// @produce     json
+// @param       id  path     int  true "Token ID"
+// @success     200 {object} api.DefaultJobApiResponse "Success message"
+// @failure     400 {object} api.ErrorResponse         "Bad Request"
+// @failure     401 {object} api.ErrorResponse         "Unauthorized"
+// @failure     403 {object} api.ErrorResponse         "Forbidden"
+// @failure     404 {object} api.ErrorResponse         "Token not found"
+// @failure     500 {object} api.ErrorResponse         "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /tokens/{id} [delete]
+func (api *RestApi) deleteApiToken(rw http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		handleError(fmt.Errorf("parsing token id failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	repo := repository.GetApiTokenRepository()
+	token, err := repo.Get(id)
+	if err == sql.ErrNoRows {
+		handleError(fmt.Errorf("api token not found: %d", id), http.StatusNotFound, rw)
+		return
+	} else if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	if me := repository.GetUserFromContext(r.Context()); me != nil && token.Username != me.Username && !me.HasRole(schema.RoleAdmin) {
+		handleError(errors.New("only admins are allowed to revoke the api tokens of others"), http.StatusForbidden, rw)
+		return
+	}
+
+	if err := repo.Delete(id); err == sql.ErrNoRows {
+		handleError(fmt.Errorf("api token not found: %d", id), http.StatusNotFound, rw)
+		return
+	} else if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	log.Infof("revoked api token '%s' of user '%s'", token.Name, token.Username)
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(DefaultJobApiResponse{
+		Message: fmt.Sprintf("Successfully revoked api token %d", id),
+	})
+}
+
 func (api *RestApi) getRoles(rw http.ResponseWriter, r *http.Request) {
 	err := securedCheck(r)
 	if err != nil {
@@ -1429,6 +3153,166 @@ func (api *RestApi) updateConfiguration(rw http.ResponseWriter, r *http.Request)
 	rw.Write([]byte("success"))
 }
 
+// updateNodeStates godoc
+// @summary     Updates the state of nodes
+// @tags Nodes
+// @description Sets scheduler state, health state, health info and hardware inventory of the listed nodes.
+// @description Unknown nodes are added. Changes of the node state, health state or reason are recorded in the node state history.
+// @description All nodes are updated or none. Updates with a timestamp older than the stored state of a node are skipped.
+// @accept      json
+// @produce     json
+// @param       request body     api.UpdateNodeStatesRequest true "Cluster and list of node states"
+// @success     200     {object} api.DefaultJobApiResponse    "Success message"
+// @failure     400     {object} api.ErrorResponse            "Bad Request"
+// @failure     401     {object} api.ErrorResponse            "Unauthorized"
+// @failure     403     {object} api.ErrorResponse            "Forbidden"
+// @failure     500     {object} api.ErrorResponse            "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /nodestate/ [post]
+func (api *RestApi) updateNodeStates(rw http.ResponseWriter, r *http.Request) {
+	req := UpdateNodeStatesRequest{}
+	if err := decode(r.Body, &req); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	if archive.GetCluster(req.Cluster) == nil {
+		handleError(fmt.Errorf("unknown cluster: %s", req.Cluster), http.StatusBadRequest, rw)
+		return
+	}
+	if err := checkTokenCluster(r, req.Cluster); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+	if req.TimeStamp == 0 {
+		req.TimeStamp = time.Now().Unix()
+	}
+
+	nodes := make([]*schema.Node, 0, len(req.Nodes))
+	for _, n := range req.Nodes {
+		if n.Hostname == "" {
+			handleError(errors.New("the field 'hostname' is required"), http.StatusBadRequest, rw)
+			return
+		}
+
+		if n.SubCluster == "" {
+			n.SubCluster, _ = archive.GetSubClusterByNode(req.Cluster, n.Hostname)
+		}
+
+		nodes = append(nodes, &schema.Node{
+			Hostname:    n.Hostname,
+			Cluster:     req.Cluster,
+			SubCluster:  n.SubCluster,
+			NodeState:   n.NodeState,
+			HealthState: n.HealthState,
+			Reason:      n.Reason,
+			TimeStamp:   req.TimeStamp,
+			HealthInfo:  n.HealthInfo,
+			Inventory:   n.Inventory,
+		})
+	}
+
+	changed, err := repository.GetNodeRepository().UpdateNodeStates(nodes)
+	if err != nil {
+		handleError(fmt.Errorf("updating node states failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+	for _, node := range changed {
+		webhook.FireNodeStateChanged(node)
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(DefaultJobApiResponse{
+		Message: "success",
+	})
+}
+
+// getNodeStates godoc
+// @summary     Lists the states of all nodes of a cluster
+// @tags Nodes
+// @description Get the scheduler state, health state, health info and hardware inventory of all known nodes of a cluster.
+// @description Without a cluster, the nodes of all clusters the API token is valid for are returned.
+// @produce     json
+// @param       cluster path     string true "Cluster name"
+// @param       state   query    string false "Node State" Enums(allocated, reserved, idle, mixed, down, drain, maintenance, unknown)
+// @success     200     {object} api.GetNodeStatesApiResponse "Array of nodes"
+// @failure     400     {object} api.ErrorResponse            "Bad Request"
+// @failure     401     {object} api.ErrorResponse            "Unauthorized"
+// @failure     403     {object} api.ErrorResponse            "Forbidden"
+// @failure     500     {object} api.ErrorResponse            "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /nodestate/ [get]
+// @router      /nodestate/{cluster} [get]
+func (api *RestApi) getNodeStates(rw http.ResponseWriter, r *http.Request) {
+	filter := &model.NodeFilter{}
+	if cluster, ok := mux.Vars(r)["cluster"]; ok {
+		if err := checkTokenCluster(r, cluster); err != nil {
+			handleError(err, http.StatusForbidden, rw)
+			return
+		}
+		filter.Cluster = &model.StringInput{Eq: &cluster}
+	} else if token := repository.GetApiTokenFromContext(r.Context()); token != nil && len(token.Clusters) != 0 {
+		filter.Cluster = &model.StringInput{In: token.Clusters}
+	}
+	if r.URL.Query().Has("state") {
+		state := schema.NodeState(r.URL.Query().Get("state"))
+		if !state.Valid() {
+			handleError(fmt.Errorf("invalid query parameter value: state"), http.StatusBadRequest, rw)
+			return
+		}
+		filter.NodeState = []schema.NodeState{state}
+	}
+
+	nodes, err := repository.GetNodeRepository().QueryNodes([]*model.NodeFilter{filter}, nil)
+	if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	bw := bufio.NewWriter(rw)
+	defer bw.Flush()
+
+	if err := json.NewEncoder(bw).Encode(GetNodeStatesApiResponse{Nodes: nodes}); err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+}
+
+// getNodeState godoc
+// @summary     Get the state of a node
+// @tags Nodes
+// @description Get the scheduler state, health state, health info and hardware inventory of a node.
+// @produce     json
+// @param       cluster path     string true "Cluster name"
+// @param       host    path     string true "Hostname"
+// @success     200     {object} schema.Node                  "Node"
+// @failure     401     {object} api.ErrorResponse            "Unauthorized"
+// @failure     403     {object} api.ErrorResponse            "Forbidden"
+// @failure     404     {object} api.ErrorResponse            "Node not found"
+// @failure     500     {object} api.ErrorResponse            "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /nodestate/{cluster}/{host} [get]
+func (api *RestApi) getNodeState(rw http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	if err := checkTokenCluster(r, vars["cluster"]); err != nil {
+		handleError(err, http.StatusForbidden, rw)
+		return
+	}
+	node, err := repository.GetNodeRepository().FindNode(vars["cluster"], vars["host"])
+	if err == sql.ErrNoRows {
+		handleError(fmt.Errorf("node not found: %s", vars["host"]), http.StatusNotFound, rw)
+		return
+	} else if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	json.NewEncoder(rw).Encode(node)
+}
+
 func (api *RestApi) putMachineState(rw http.ResponseWriter, r *http.Request) {
 	if api.MachineStateDir == "" {
 		http.Error(rw, "REST > machine state not enabled", http.StatusNotFound)
@@ -1438,6 +3322,10 @@ func (api *RestApi) putMachineState(rw http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	cluster := vars["cluster"]
 	host := vars["host"]
+	if err := checkTokenCluster(r, cluster); err != nil {
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
 	dir := filepath.Join(api.MachineStateDir, cluster)
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		http.Error(rw, err.Error(), http.StatusInternalServerError)
@@ -1467,6 +3355,10 @@ func (api *RestApi) getMachineState(rw http.ResponseWriter, r *http.Request) {
 	}
 
 	vars := mux.Vars(r)
+	if err := checkTokenCluster(r, vars["cluster"]); err != nil {
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
 	filename := filepath.Join(api.MachineStateDir, vars["cluster"], fmt.Sprintf("%s.json", vars["host"]))
 
 	// Sets the content-type and 'Last-Modified' Header and so on automatically
@@ -0,0 +1,177 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package archiver
+
+import (
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/internal/util"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// Scopes and metrics to archive for a job as well as the size limit
+// for the archived metric data.
+type archiveSelection struct {
+	scopes      []schema.MetricScope
+	metrics     []string // nil means all metrics
+	maxDataSize int      // in bytes, 0 means no limit
+	downsample  *schema.ArchiveDownsample
+}
+
+func getArchivePolicy(cluster string) *schema.ArchivePolicy {
+	for _, c := range config.Keys.Clusters {
+		if c.Name == cluster {
+			return c.ArchivePolicy
+		}
+	}
+
+	return nil
+}
+
+func inRange(r *schema.IntRange, value int) bool {
+	if r == nil {
+		return true
+	}
+
+	return value >= r.From && (r.To == 0 || value <= r.To)
+}
+
+func hasAnyTag(job *schema.Job, tags []string) bool {
+	for _, tag := range job.Tags {
+		if util.Contains(tags, tag.Name) || util.Contains(tags, tag.Type+":"+tag.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ruleMatches(rule *schema.ArchiveRule, job *schema.Job) bool {
+	return (len(rule.SubClusters) == 0 || util.Contains(rule.SubClusters, job.SubCluster)) &&
+		inRange(rule.NumNodes, int(job.NumNodes)) &&
+		inRange(rule.Duration, int(job.Duration)) &&
+		(len(rule.Tags) == 0 || hasAnyTag(job, rule.Tags))
+}
+
+// Select the scopes and metrics to archive for job according to the archive
+// policy of its cluster. The node scope is always included.
+func selectArchiveScopes(job *schema.Job) archiveSelection {
+	sel := archiveSelection{scopes: []schema.MetricScope{schema.MetricScopeNode}}
+
+	policy := getArchivePolicy(job.Cluster)
+	if policy != nil {
+		sel.maxDataSize = policy.MaxDataSize * 1024 * 1024
+		sel.downsample = policy.Downsample
+
+		for _, rule := range policy.Rules {
+			if !ruleMatches(&rule, job) {
+				continue
+			}
+
+			for _, scope := range rule.Scopes {
+				if scope == schema.MetricScopeAccelerator && job.NumAcc == 0 {
+					continue
+				}
+				if scope != schema.MetricScopeNode && !util.Contains(sel.scopes, scope) {
+					sel.scopes = append(sel.scopes, scope)
+				}
+			}
+			if len(rule.Metrics) > 0 {
+				sel.metrics = rule.Metrics
+			}
+
+			return sel
+		}
+	}
+
+	if job.NumNodes <= 8 {
+		// This will add the native scope if core scope is not available
+		sel.scopes = append(sel.scopes, schema.MetricScopeCore)
+	}
+
+	if job.NumAcc > 0 {
+		sel.scopes = append(sel.scopes, schema.MetricScopeAccelerator)
+	}
+
+	return sel
+}
+
+// Next coarser scope used as fallback if the archived data gets too large.
+var coarserScope = map[schema.MetricScope]schema.MetricScope{
+	schema.MetricScopeHWThread:     schema.MetricScopeCore,
+	schema.MetricScopeCore:         schema.MetricScopeMemoryDomain,
+	schema.MetricScopeMemoryDomain: schema.MetricScopeSocket,
+	schema.MetricScopeSocket:       schema.MetricScopeNode,
+	schema.MetricScopeAccelerator:  schema.MetricScopeNode,
+}
+
+// Replace the finest scope in scopes by the next coarser one. Returns false if
+// only the node scope is left.
+func coarsenScopes(scopes []schema.MetricScope) ([]schema.MetricScope, bool) {
+	finest := -1
+	for i, scope := range scopes {
+		if scope == schema.MetricScopeNode {
+			continue
+		}
+		if finest == -1 || scope.LT(scopes[finest]) {
+			finest = i
+		}
+	}
+
+	if finest == -1 {
+		return scopes, false
+	}
+
+	res := make([]schema.MetricScope, 0, len(scopes))
+	for i, scope := range scopes {
+		if i == finest {
+			scope = coarserScope[scope]
+		}
+		if !util.Contains(res, scope) {
+			res = append(res, scope)
+		}
+	}
+
+	return res, true
+}
+
+// Returns the data to archive: the selected metrics, downsampled if the job
+// exceeds the configured duration or size thresholds.
+func (sel *archiveSelection) prepare(job *schema.Job, jobData schema.JobData) schema.JobData {
+	data := selectArchiveMetrics(jobData, sel.metrics)
+
+	ds := sel.downsample
+	if ds == nil || ds.Timestep <= 0 {
+		return data
+	}
+
+	if (ds.MinDuration > 0 && int(job.Duration) >= ds.MinDuration) ||
+		(ds.MinDataSize > 0 && data.Size() >= ds.MinDataSize*1024*1024) {
+		if resampled, ok := archive.ResampleJobData(&data, ds.Timestep); ok {
+			log.Debugf("archive job %d downsampled to timestep %d", job.JobID, ds.Timestep)
+			return resampled
+		}
+	}
+
+	return data
+}
+
+// Returns the subset of jobData for the given metrics, or jobData if metrics
+// is empty. The statistics are always computed from all metrics.
+func selectArchiveMetrics(jobData schema.JobData, metrics []string) schema.JobData {
+	if len(metrics) == 0 {
+		return jobData
+	}
+
+	res := make(schema.JobData, len(metrics))
+	for _, metric := range metrics {
+		if data, ok := jobData[metric]; ok {
+			res[metric] = data
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,199 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package archiver
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func newJob(subCluster string, numNodes, numAcc, duration int32, tags ...*schema.Tag) *schema.Job {
+	return &schema.Job{BaseJob: schema.BaseJob{
+		Cluster: "fritz", SubCluster: subCluster, NumNodes: numNodes, NumAcc: numAcc, Duration: duration, Tags: tags,
+	}}
+}
+
+func TestRuleMatches(t *testing.T) {
+	debug := &schema.Tag{Type: "debug", Name: "scopes"}
+	for name, tc := range map[string]struct {
+		rule schema.ArchiveRule
+		job  *schema.Job
+		want bool
+	}{
+		"empty rule":           {schema.ArchiveRule{}, newJob("main", 1, 0, 60), true},
+		"subcluster":           {schema.ArchiveRule{SubClusters: []string{"main"}}, newJob("main", 1, 0, 60), true},
+		"other subcluster":     {schema.ArchiveRule{SubClusters: []string{"spr"}}, newJob("main", 1, 0, 60), false},
+		"nodes in range":       {schema.ArchiveRule{NumNodes: &schema.IntRange{From: 2, To: 4}}, newJob("main", 4, 0, 60), true},
+		"nodes below range":    {schema.ArchiveRule{NumNodes: &schema.IntRange{From: 2, To: 4}}, newJob("main", 1, 0, 60), false},
+		"nodes above range":    {schema.ArchiveRule{NumNodes: &schema.IntRange{From: 2, To: 4}}, newJob("main", 5, 0, 60), false},
+		"open range":           {schema.ArchiveRule{Duration: &schema.IntRange{From: 3600}}, newJob("main", 1, 0, 86400), true},
+		"too short":            {schema.ArchiveRule{Duration: &schema.IntRange{From: 3600}}, newJob("main", 1, 0, 60), false},
+		"tag by name":          {schema.ArchiveRule{Tags: []string{"scopes"}}, newJob("main", 1, 0, 60, debug), true},
+		"tag by type and name": {schema.ArchiveRule{Tags: []string{"debug:scopes"}}, newJob("main", 1, 0, 60, debug), true},
+		"other tag":            {schema.ArchiveRule{Tags: []string{"debug:other"}}, newJob("main", 1, 0, 60, debug), false},
+		"no tags":              {schema.ArchiveRule{Tags: []string{"scopes"}}, newJob("main", 1, 0, 60), false},
+		"all conditions": {schema.ArchiveRule{
+			SubClusters: []string{"main"}, NumNodes: &schema.IntRange{From: 1, To: 1}, Tags: []string{"scopes"},
+		}, newJob("main", 1, 0, 60, debug), true},
+	} {
+		if got := ruleMatches(&tc.rule, tc.job); got != tc.want {
+			t.Errorf("%s: want %v, got %v", name, tc.want, got)
+		}
+	}
+}
+
+func TestCoarsenScopes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		scopes []schema.MetricScope
+		want   []schema.MetricScope
+		ok     bool
+	}{
+		"node only": {
+			[]schema.MetricScope{schema.MetricScopeNode},
+			[]schema.MetricScope{schema.MetricScopeNode}, false,
+		},
+		"hwthread": {
+			[]schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeHWThread},
+			[]schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeCore}, true,
+		},
+		"finest first": {
+			[]schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeSocket, schema.MetricScopeCore},
+			[]schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeSocket, schema.MetricScopeMemoryDomain}, true,
+		},
+		"merged with existing": {
+			[]schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeSocket, schema.MetricScopeMemoryDomain},
+			[]schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeSocket}, true,
+		},
+		"socket to node": {
+			[]schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeSocket},
+			[]schema.MetricScope{schema.MetricScopeNode}, true,
+		},
+		"accelerator to node": {
+			[]schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeAccelerator},
+			[]schema.MetricScope{schema.MetricScopeNode}, true,
+		},
+	} {
+		got, ok := coarsenScopes(tc.scopes)
+		if ok != tc.ok || !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: want %v (%v), got %v (%v)", name, tc.want, tc.ok, got, ok)
+		}
+	}
+}
+
+func TestSelectArchiveScopes(t *testing.T) {
+	policy := &schema.ArchivePolicy{
+		MaxDataSize: 10,
+		Rules: []schema.ArchiveRule{
+			{
+				SubClusters: []string{"gpu"},
+				Scopes:      []schema.MetricScope{schema.MetricScopeAccelerator, schema.MetricScopeSocket},
+				Metrics:     []string{"acc_utilization"},
+			},
+			{
+				NumNodes: &schema.IntRange{From: 1, To: 1},
+				Scopes:   []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeHWThread, schema.MetricScopeAccelerator},
+			},
+		},
+	}
+	clusters := config.Keys.Clusters
+	config.Keys.Clusters = []*schema.ClusterConfig{{Name: "fritz", ArchivePolicy: policy}}
+	t.Cleanup(func() { config.Keys.Clusters = clusters })
+
+	for name, tc := range map[string]struct {
+		job  *schema.Job
+		want archiveSelection
+	}{
+		"first rule": {newJob("gpu", 1, 4, 60), archiveSelection{
+			scopes:  []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeAccelerator, schema.MetricScopeSocket},
+			metrics: []string{"acc_utilization"}, maxDataSize: 10 * 1024 * 1024,
+		}},
+		"accelerator scope without accelerators": {newJob("main", 1, 0, 60), archiveSelection{
+			scopes:      []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeHWThread},
+			maxDataSize: 10 * 1024 * 1024,
+		}},
+		"default": {newJob("main", 4, 0, 60), archiveSelection{
+			scopes:      []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeCore},
+			maxDataSize: 10 * 1024 * 1024,
+		}},
+		"default for large jobs": {newJob("main", 16, 2, 60), archiveSelection{
+			scopes:      []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeAccelerator},
+			maxDataSize: 10 * 1024 * 1024,
+		}},
+	} {
+		if got := selectArchiveScopes(tc.job); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: want %#v, got %#v", name, tc.want, got)
+		}
+	}
+
+	// Clusters without a policy get the default
+	job := newJob("main", 1, 0, 60)
+	job.Cluster = "alex"
+	want := archiveSelection{scopes: []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeCore}}
+	if got := selectArchiveScopes(job); !reflect.DeepEqual(got, want) {
+		t.Errorf("no policy: want %#v, got %#v", want, got)
+	}
+}
+
+// Metric data with one series of `n` points at a timestep of 60 seconds for
+// each metric.
+func newJobData(n int, metrics ...string) schema.JobData {
+	jobData := make(schema.JobData)
+	for _, metric := range metrics {
+		data := make([]schema.Float, n)
+		for i := range data {
+			data[i] = schema.Float(i % 7)
+		}
+		jobData[metric] = map[schema.MetricScope]*schema.JobMetric{
+			schema.MetricScopeNode: {Timestep: 60, Series: []schema.Series{{Hostname: "f0101", Data: data}}},
+		}
+	}
+	return jobData
+}
+
+func TestPrepareDownsample(t *testing.T) {
+	for name, tc := range map[string]struct {
+		sel              archiveSelection
+		duration         int32
+		points           int
+		wantTimestep     int
+		wantOriginal     int
+		wantSeriesLength int
+	}{
+		"no downsampling": {archiveSelection{}, 72000, 1200, 60, 0, 1200},
+		"long job": {archiveSelection{downsample: &schema.ArchiveDownsample{Timestep: 600, MinDuration: 3600}},
+			72000, 1200, 600, 60, 120},
+		"short job": {archiveSelection{downsample: &schema.ArchiveDownsample{Timestep: 600, MinDuration: 3600}},
+			1800, 1200, 60, 0, 1200},
+		"small data": {archiveSelection{downsample: &schema.ArchiveDownsample{Timestep: 600, MinDataSize: 1}},
+			72000, 1200, 60, 0, 1200},
+		"large data": {archiveSelection{downsample: &schema.ArchiveDownsample{Timestep: 600, MinDataSize: 1}},
+			72000, 150000, 600, 60, 15000},
+		"timestep not a multiple": {archiveSelection{downsample: &schema.ArchiveDownsample{Timestep: 90, MinDuration: 3600}},
+			72000, 1200, 60, 0, 1200},
+	} {
+		job := newJob("main", 1, 0, tc.duration)
+		data := tc.sel.prepare(job, newJobData(tc.points, "flops_any", "mem_bw"))
+
+		for metric, scopes := range data {
+			jm := scopes[schema.MetricScopeNode]
+			if jm.Timestep != tc.wantTimestep || jm.OriginalTimestep != tc.wantOriginal ||
+				len(jm.Series[0].Data) != tc.wantSeriesLength {
+				t.Errorf("%s: %s: want timestep %d (original %d) with %d points, got %d (%d) with %d", name, metric,
+					tc.wantTimestep, tc.wantOriginal, tc.wantSeriesLength,
+					jm.Timestep, jm.OriginalTimestep, len(jm.Series[0].Data))
+			}
+		}
+	}
+
+	// Only the selected metrics are archived, then downsampled
+	sel := archiveSelection{metrics: []string{"flops_any"}, downsample: &schema.ArchiveDownsample{Timestep: 600, MinDuration: 3600}}
+	data := sel.prepare(newJob("main", 1, 0, 72000), newJobData(1200, "flops_any", "mem_bw"))
+	if len(data) != 1 || data["flops_any"][schema.MetricScopeNode].Timestep != 600 {
+		t.Errorf("unexpected archived data: %#v", data)
+	}
+}
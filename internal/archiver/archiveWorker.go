@@ -6,10 +6,12 @@ package archiver
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/internal/webhook"
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 	sq "github.com/Masterminds/squirrel"
@@ -39,9 +41,21 @@ func archivingWorker() {
 			start := time.Now()
 			// not using meta data, called to load JobMeta into Cache?
 			// will fail if job meta not in repository
-			if _, err := jobRepo.FetchMetadata(job); err != nil {
-				log.Errorf("archiving job (dbid: %d) failed at check metadata step: %s", job.ID, err.Error())
-				jobRepo.UpdateMonitoringStatus(job.ID, schema.MonitoringStatusArchivingFailed)
+			var err error
+			if _, err = jobRepo.FetchMetadata(job); err != nil {
+				archivingFailed(job, "check metadata", err, true)
+				continue
+			}
+
+			// Tags are needed to select the archive policy rule
+			if job.Tags, err = jobRepo.GetArchiveTags(&job.ID); err != nil {
+				archivingFailed(job, "get tags", err, true)
+				continue
+			}
+
+			// Comments and annotations are kept in the job meta data of the archive
+			if job.Comments, err = jobRepo.GetArchiveComments(job.ID); err != nil {
+				archivingFailed(job, "get comments", err, true)
 				continue
 			}
 
@@ -49,34 +63,48 @@ func archivingWorker() {
 			// TODO: Maybe use context with cancel/timeout here
 			jobMeta, err := ArchiveJob(job, context.Background())
 			if err != nil {
-				log.Errorf("archiving job (dbid: %d) failed at archiving job step: %s", job.ID, err.Error())
-				jobRepo.UpdateMonitoringStatus(job.ID, schema.MonitoringStatusArchivingFailed)
+				archivingFailed(job, "archiving job", err, true)
 				continue
 			}
 
 			stmt := sq.Update("job").Where("job.id = ?", job.ID)
 
 			if stmt, err = jobRepo.UpdateFootprint(stmt, jobMeta); err != nil {
-				log.Errorf("archiving job (dbid: %d) failed at update Footprint step: %s", job.ID, err.Error())
+				archivingFailed(job, "update footprint", err, false)
 				continue
 			}
 			if stmt, err = jobRepo.UpdateEnergy(stmt, jobMeta); err != nil {
-				log.Errorf("archiving job (dbid: %d) failed at update Energy step: %s", job.ID, err.Error())
+				archivingFailed(job, "update energy", err, false)
 				continue
 			}
 			// Update the jobs database entry one last time:
 			stmt = jobRepo.MarkArchived(stmt, schema.MonitoringStatusArchivingSuccessful)
 			if err := jobRepo.Execute(stmt); err != nil {
-				log.Errorf("archiving job (dbid: %d) failed at db execute: %s", job.ID, err.Error())
+				archivingFailed(job, "db execute", err, false)
 				continue
 			}
 			log.Debugf("archiving job %d took %s", job.JobID, time.Since(start))
 			log.Printf("archiving job (dbid: %d) successful", job.ID)
+			jobRepo.AddJobEvent(job.ID, schema.JobEventArchived, "", nil, "")
+			job.MonitoringStatus = schema.MonitoringStatusArchivingSuccessful
+			webhook.FireJobEvent(schema.WebhookEventJobArchived, job)
 			archivePending.Done()
 		}
 	}
 }
 
+// Record that archiving the job failed at `step`. The monitoring status is
+// only set to failed if `markFailed` is set.
+func archivingFailed(job *schema.Job, step string, err error, markFailed bool) {
+	log.Errorf("archiving job (dbid: %d) failed at %s step: %s", job.ID, step, err.Error())
+	jobRepo.AddJobEvent(job.ID, schema.JobEventArchivingFailed, "", nil, fmt.Sprintf("%s: %s", step, err.Error()))
+	if markFailed {
+		jobRepo.UpdateMonitoringStatus(job.ID, schema.MonitoringStatusArchivingFailed)
+		job.MonitoringStatus = schema.MonitoringStatusArchivingFailed
+	}
+	webhook.FireJobEvent(schema.WebhookEventJobArchivingFailed, job)
+}
+
 // Trigger async archiving
 func TriggerArchiving(job *schema.Job) {
 	if archiveChannel == nil {
@@ -23,16 +23,8 @@ func ArchiveJob(job *schema.Job, ctx context.Context) (*schema.JobMeta, error) {
 		allMetrics = append(allMetrics, mc.Name)
 	}
 
-	scopes := []schema.MetricScope{schema.MetricScopeNode}
-	// FIXME: Add a config option for this
-	if job.NumNodes <= 8 {
-		// This will add the native scope if core scope is not available
-		scopes = append(scopes, schema.MetricScopeCore)
-	}
-
-	if job.NumAcc > 0 {
-		scopes = append(scopes, schema.MetricScopeAccelerator)
-	}
+	sel := selectArchiveScopes(job)
+	scopes := sel.scopes
 
 	jobData, err := metricDataDispatcher.LoadData(job, allMetrics, scopes, ctx, 0) // 0 Resulotion-Value retrieves highest res (60s)
 	if err != nil {
@@ -40,6 +32,23 @@ func ArchiveJob(job *schema.Job, ctx context.Context) (*schema.JobMeta, error) {
 		return nil, err
 	}
 
+	archiveData := sel.prepare(job, jobData)
+	for sel.maxDataSize > 0 && archiveData.Size() > sel.maxDataSize {
+		var ok bool
+		if scopes, ok = coarsenScopes(scopes); !ok {
+			log.Warnf("archived data of job %d exceeds maximum size with node scope only", job.JobID)
+			break
+		}
+
+		log.Debugf("archived data of job %d exceeds maximum size, fall back to scopes %v", job.JobID, scopes)
+		jobData, err = metricDataDispatcher.LoadData(job, allMetrics, scopes, ctx, 0)
+		if err != nil {
+			log.Error("Error wile loading job data for archiving")
+			return nil, err
+		}
+		archiveData = sel.prepare(job, jobData)
+	}
+
 	jobMeta := &schema.JobMeta{
 		BaseJob:    job.BaseJob,
 		StartTime:  job.StartTime.Unix(),
@@ -78,5 +87,5 @@ func ArchiveJob(job *schema.Job, ctx context.Context) (*schema.JobMeta, error) {
 		return jobMeta, nil
 	}
 
-	return jobMeta, archive.GetHandle().ImportJob(jobMeta, &jobData)
+	return jobMeta, archive.GetHandle().ImportJob(jobMeta, &archiveData)
 }
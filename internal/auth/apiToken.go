@@ -0,0 +1,113 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// The last use of a token is only written to the database once per minute.
+const lastUsedInterval = 60
+
+// AuthViaApiToken authenticates a request by an API token. The roles are
+// taken from the database, the token can only restrict them further.
+// Returns nil if the request has no API token.
+func AuthViaApiToken(r *http.Request) (*schema.User, *schema.ApiToken, error) {
+	rawtoken := getRawToken(r)
+	if !strings.HasPrefix(rawtoken, schema.ApiTokenPrefix) {
+		return nil, nil, nil
+	}
+
+	repo := repository.GetApiTokenRepository()
+	token, err := repo.FindByToken(rawtoken)
+	if err == sql.ErrNoRows {
+		return nil, nil, errors.New("invalid api token")
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now().Unix()
+	if token.Expired(now) {
+		return nil, nil, errors.New("api token expired")
+	}
+
+	dbUser, err := repository.GetUserRepository().GetUser(token.Username)
+	if err != nil {
+		log.Warnf("Could not find user '%s' of api token '%s'", token.Username, token.Name)
+		return nil, nil, errors.New("unknown user")
+	}
+
+	if now-token.LastUsed >= lastUsedInterval {
+		token.LastUsed = now
+		repo.UpdateLastUsed(token.ID, now)
+	}
+
+	return &schema.User{
+		Username:   dbUser.Username,
+		Name:       dbUser.Name,
+		Email:      dbUser.Email,
+		Roles:      dbUser.Roles,
+		Projects:   dbUser.Projects,
+		AuthType:   schema.AuthToken,
+		AuthSource: -1,
+	}, token, nil
+}
+
+// Authenticate a request by an API token or a JWT, the token is nil for
+// JWTs.
+func (auth *Authentication) authViaToken(
+	rw http.ResponseWriter,
+	r *http.Request,
+) (*schema.User, *schema.ApiToken, error) {
+	if strings.HasPrefix(getRawToken(r), schema.ApiTokenPrefix) {
+		return AuthViaApiToken(r)
+	}
+
+	user, err := auth.JwtAuth.AuthViaJWT(rw, r)
+	return user, nil, err
+}
+
+// requiredScope returns the scope an API token needs for a request to the
+// REST API. Requests that do not change anything only need the read scope.
+func requiredScope(r *http.Request) schema.ApiTokenScope {
+	// Strip the prefix of the router, e.g. '/api'
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if i := strings.Index(path, "/"); i >= 0 {
+		path = path[i:]
+	}
+
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return schema.ApiTokenScopeRead
+	}
+
+	switch {
+	case strings.HasPrefix(path, "/jobs/start_job"),
+		strings.HasPrefix(path, "/jobs/stop_job"),
+		strings.HasPrefix(path, "/jobs/update_job/"):
+		return schema.ApiTokenScopeJobControl
+	case strings.HasPrefix(path, "/jobs/tag_job/"),
+		strings.HasPrefix(path, "/jobs/edit_meta/"),
+		strings.HasPrefix(path, "/jobs/import/"),
+		strings.HasPrefix(path, "/jobs/delete_job"),
+		strings.HasPrefix(path, "/jobs/restore_job/"):
+		return schema.ApiTokenScopeJobEdit
+	case strings.HasPrefix(path, "/jobs/"):
+		// Job queries with a request body
+		return schema.ApiTokenScopeRead
+	case strings.HasPrefix(path, "/nodestate/"),
+		strings.HasPrefix(path, "/machine_state/"):
+		return schema.ApiTokenScopeNodeState
+	default:
+		return schema.ApiTokenScopeAll
+	}
+}
@@ -291,12 +291,20 @@ func (auth *Authentication) AuthApi(
 	onfailure func(rw http.ResponseWriter, r *http.Request, authErr error),
 ) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-		user, err := auth.JwtAuth.AuthViaJWT(rw, r)
+		user, token, err := auth.authViaToken(rw, r)
 		if err != nil {
 			log.Infof("auth api -> authentication failed: %s", err.Error())
 			onfailure(rw, r, err)
 			return
 		}
+		if token != nil {
+			if !token.HasScope(requiredScope(r)) {
+				log.Infof("auth api -> authentication failed: api token '%s' misses scope", token.Name)
+				onfailure(rw, r, errors.New("unauthorized (missing api token scope)"))
+				return
+			}
+			r = r.WithContext(context.WithValue(r.Context(), repository.ContextApiTokenKey, token))
+		}
 		if user != nil {
 			switch {
 			case len(user.Roles) == 1:
@@ -326,12 +334,20 @@ func (auth *Authentication) AuthUserApi(
 	onfailure func(rw http.ResponseWriter, r *http.Request, authErr error),
 ) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-		user, err := auth.JwtAuth.AuthViaJWT(rw, r)
+		user, token, err := auth.authViaToken(rw, r)
 		if err != nil {
 			log.Infof("auth user api -> authentication failed: %s", err.Error())
 			onfailure(rw, r, err)
 			return
 		}
+		if token != nil {
+			if !token.HasScope(requiredScope(r)) {
+				log.Infof("auth user api -> authentication failed: api token '%s' misses scope", token.Name)
+				onfailure(rw, r, errors.New("unauthorized (missing api token scope)"))
+				return
+			}
+			r = r.WithContext(context.WithValue(r.Context(), repository.ContextApiTokenKey, token))
+		}
 		if user != nil {
 			switch {
 			case len(user.Roles) == 1:
@@ -47,15 +47,21 @@ func (ja *JWTAuthenticator) Init() error {
 	return nil
 }
 
-func (ja *JWTAuthenticator) AuthViaJWT(
-	rw http.ResponseWriter,
-	r *http.Request,
-) (*schema.User, error) {
+// Tokens are sent in the 'X-Auth-Token' or the 'Authorization' header.
+func getRawToken(r *http.Request) string {
 	rawtoken := r.Header.Get("X-Auth-Token")
 	if rawtoken == "" {
 		rawtoken = r.Header.Get("Authorization")
 		rawtoken = strings.TrimPrefix(rawtoken, "Bearer ")
 	}
+	return rawtoken
+}
+
+func (ja *JWTAuthenticator) AuthViaJWT(
+	rw http.ResponseWriter,
+	r *http.Request,
+) (*schema.User, error) {
+	rawtoken := getRawToken(r)
 
 	// there is no token
 	if rawtoken == "" {
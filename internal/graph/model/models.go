@@ -3,3 +3,31 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 package model
+
+// SavedJobFilter is a named set of job filters. It can be private to its
+// owner, shared with the managers of a project or shared globally.
+type SavedJobFilter struct {
+	Name      string       `json:"name" db:"name"`
+	Owner     string       `json:"owner" db:"owner"`
+	Shared    string       `json:"shared" db:"shared"`
+	Project   string       `json:"project" db:"project"`
+	RawFilter []byte       `json:"-" db:"filter"`
+	Filter    []*JobFilter `json:"filter"`
+	CreatedAt int64        `json:"createdAt" db:"created_at"`
+	UpdatedAt int64        `json:"updatedAt" db:"updated_at"`
+	ID        int64        `json:"id" db:"id"`
+}
+
+// JobCollection is a list of jobs curated by its owner. It can be shared
+// read-only with other users and with the managers of projects.
+type JobCollection struct {
+	Name           string   `json:"name" db:"name"`
+	Owner          string   `json:"owner" db:"owner"`
+	Description    string   `json:"description" db:"description"`
+	SharedUsers    []string `json:"sharedUsers"`
+	SharedProjects []string `json:"sharedProjects"`
+	CreatedAt      int64    `json:"createdAt" db:"created_at"`
+	UpdatedAt      int64    `json:"updatedAt" db:"updated_at"`
+	ID             int64    `json:"id" db:"id"`
+	NumJobs        int      `json:"numJobs"`
+}
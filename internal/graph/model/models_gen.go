@@ -11,6 +11,16 @@ import (
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 )
 
+type AllocationInput struct {
+	Project   string    `json:"project"`
+	Cluster   string    `json:"cluster"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	CoreHours *float64  `json:"coreHours,omitempty"`
+	AccHours  *float64  `json:"accHours,omitempty"`
+	Energy    *float64  `json:"energy,omitempty"`
+}
+
 type Count struct {
 	Name  string `json:"name"`
 	Count int    `json:"count"`
@@ -55,6 +65,7 @@ type JobFilter struct {
 	User            *StringInput      `json:"user,omitempty"`
 	Project         *StringInput      `json:"project,omitempty"`
 	JobName         *StringInput      `json:"jobName,omitempty"`
+	Search          *string           `json:"search,omitempty"`
 	Cluster         *StringInput      `json:"cluster,omitempty"`
 	Partition       *StringInput      `json:"partition,omitempty"`
 	Duration        *schema.IntRange  `json:"duration,omitempty"`
@@ -64,10 +75,14 @@ type JobFilter struct {
 	NumAccelerators *schema.IntRange  `json:"numAccelerators,omitempty"`
 	NumHWThreads    *schema.IntRange  `json:"numHWThreads,omitempty"`
 	StartTime       *schema.TimeRange `json:"startTime,omitempty"`
+	SubmitTime      *schema.TimeRange `json:"submitTime,omitempty"`
+	WaitTime        *schema.IntRange  `json:"waitTime,omitempty"`
 	State           []schema.JobState `json:"state,omitempty"`
 	MetricStats     []*MetricStatItem `json:"metricStats,omitempty"`
 	Exclusive       *int              `json:"exclusive,omitempty"`
 	Node            *StringInput      `json:"node,omitempty"`
+	SavedFilter     *string           `json:"savedFilter,omitempty"`
+	Collection      *string           `json:"collection,omitempty"`
 }
 
 type JobLink struct {
@@ -93,6 +108,8 @@ type JobResultList struct {
 	Limit       *int          `json:"limit,omitempty"`
 	Count       *int          `json:"count,omitempty"`
 	HasNextPage *bool         `json:"hasNextPage,omitempty"`
+	NextCursor  *string       `json:"nextCursor,omitempty"`
+	PrevCursor  *string       `json:"prevCursor,omitempty"`
 }
 
 type JobsStatistics struct {
@@ -113,6 +130,9 @@ type JobsStatistics struct {
 	HistNumCores   []*HistoPoint        `json:"histNumCores"`
 	HistNumAccs    []*HistoPoint        `json:"histNumAccs"`
 	HistMetrics    []*MetricHistoPoints `json:"histMetrics"`
+	AvgWaitTime    int                  `json:"avgWaitTime"`
+	MaxWaitTime    int                  `json:"maxWaitTime"`
+	HistWaitTime   []*HistoPoint        `json:"histWaitTime"`
 }
 
 type MetricFootprints struct {
@@ -142,6 +162,15 @@ type MetricStatItem struct {
 type Mutation struct {
 }
 
+type NodeFilter struct {
+	Hostname    *StringInput         `json:"hostname,omitempty"`
+	Cluster     *StringInput         `json:"cluster,omitempty"`
+	SubCluster  *StringInput         `json:"subCluster,omitempty"`
+	NodeState   []schema.NodeState   `json:"nodeState,omitempty"`
+	HealthState []schema.HealthState `json:"healthState,omitempty"`
+	Reason      *StringInput         `json:"reason,omitempty"`
+}
+
 type NodeMetrics struct {
 	Host       string               `json:"host"`
 	SubCluster string               `json:"subCluster"`
@@ -164,8 +193,9 @@ type OrderByInput struct {
 }
 
 type PageRequest struct {
-	ItemsPerPage int `json:"itemsPerPage"`
-	Page         int `json:"page"`
+	ItemsPerPage int     `json:"itemsPerPage"`
+	Page         int     `json:"page"`
+	Cursor       *string `json:"cursor,omitempty"`
 }
 
 type Query struct {
@@ -198,23 +228,33 @@ type User struct {
 	Email    string `json:"email"`
 }
 
+type UtilizationPoint struct {
+	Time  time.Time `json:"time"`
+	Jobs  float64   `json:"jobs"`
+	Nodes float64   `json:"nodes"`
+	Cores float64   `json:"cores"`
+	Accs  float64   `json:"accs"`
+}
+
 type Aggregate string
 
 const (
-	AggregateUser    Aggregate = "USER"
-	AggregateProject Aggregate = "PROJECT"
-	AggregateCluster Aggregate = "CLUSTER"
+	AggregateUser      Aggregate = "USER"
+	AggregateProject   Aggregate = "PROJECT"
+	AggregateCluster   Aggregate = "CLUSTER"
+	AggregatePartition Aggregate = "PARTITION"
 )
 
 var AllAggregate = []Aggregate{
 	AggregateUser,
 	AggregateProject,
 	AggregateCluster,
+	AggregatePartition,
 }
 
 func (e Aggregate) IsValid() bool {
 	switch e {
-	case AggregateUser, AggregateProject, AggregateCluster:
+	case AggregateUser, AggregateProject, AggregateCluster, AggregatePartition:
 		return true
 	}
 	return false
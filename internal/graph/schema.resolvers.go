@@ -19,11 +19,17 @@ import (
 	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
 	"github.com/ClusterCockpit/cc-backend/internal/metricDataDispatcher"
 	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/internal/webhook"
 	"github.com/ClusterCockpit/cc-backend/pkg/archive"
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 )
 
+// Usage is the resolver for the usage field.
+func (r *allocationResolver) Usage(ctx context.Context, obj *schema.Allocation) (*schema.AllocationUsage, error) {
+	return repository.GetAllocationRepository().Usage(obj)
+}
+
 // Partitions is the resolver for the partitions field.
 func (r *clusterResolver) Partitions(ctx context.Context, obj *schema.Cluster) ([]string, error) {
 	return r.Repo.Partitions(obj.Name)
@@ -118,6 +124,21 @@ func (r *jobResolver) UserData(ctx context.Context, obj *schema.Job) (*model.Use
 	return repository.GetUserRepository().FetchUserInCtx(ctx, obj.User)
 }
 
+// Events is the resolver for the events field.
+func (r *jobResolver) Events(ctx context.Context, obj *schema.Job) ([]*schema.JobEvent, error) {
+	return r.Repo.GetJobEvents(obj.ID)
+}
+
+// Comments is the resolver for the comments field.
+func (r *jobResolver) Comments(ctx context.Context, obj *schema.Job) ([]*schema.JobComment, error) {
+	return r.Repo.GetComments(repository.GetUserFromContext(ctx), obj.ID)
+}
+
+// Event is the resolver for the event field.
+func (r *jobEventResolver) Event(ctx context.Context, obj *schema.JobEvent) (string, error) {
+	return string(obj.Event), nil
+}
+
 // Name is the resolver for the name field.
 func (r *metricValueResolver) Name(ctx context.Context, obj *schema.MetricValue) (*string, error) {
 	panic(fmt.Errorf("not implemented: Name - name"))
@@ -149,6 +170,7 @@ func (r *mutationResolver) AddTagsToJob(ctx context.Context, job string, tagIds
 	}
 
 	tags := []*schema.Tag{}
+	added := make([]int64, 0, len(tagIds))
 	for _, tagId := range tagIds {
 		tid, err := strconv.ParseInt(tagId, 10, 64)
 		if err != nil {
@@ -160,6 +182,15 @@ func (r *mutationResolver) AddTagsToJob(ctx context.Context, job string, tagIds
 			log.Warn("Error while adding tag")
 			return nil, err
 		}
+		added = append(added, tid)
+	}
+
+	if j, err := r.Repo.FindById(ctx, jid); err == nil {
+		for _, tag := range tags {
+			if slices.Contains(added, tag.ID) {
+				webhook.FireJobTagged(j, tag)
+			}
+		}
 	}
 
 	return tags, nil
@@ -191,6 +222,218 @@ func (r *mutationResolver) RemoveTagsFromJob(ctx context.Context, job string, ta
 	return tags, nil
 }
 
+// AddJobComment is the resolver for the addJobComment field.
+func (r *mutationResolver) AddJobComment(ctx context.Context, job string, text string, scope string, parentID *string, metric *string, from *int, to *int) (*schema.JobComment, error) {
+	jid, err := strconv.ParseInt(job, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing job id")
+		return nil, err
+	}
+
+	comment := &schema.JobComment{Text: text, Scope: scope}
+	if parentID != nil {
+		pid, err := strconv.ParseInt(*parentID, 10, 64)
+		if err != nil {
+			log.Warn("Error while parsing comment id")
+			return nil, err
+		}
+		comment.ParentID = &pid
+	}
+	if metric != nil {
+		comment.Metric = *metric
+	}
+	if from != nil {
+		f := int64(*from)
+		comment.From = &f
+	}
+	if to != nil {
+		t := int64(*to)
+		comment.To = &t
+	}
+
+	return r.Repo.AddComment(repository.GetUserFromContext(ctx), jid, comment)
+}
+
+// UpdateJobComment is the resolver for the updateJobComment field.
+func (r *mutationResolver) UpdateJobComment(ctx context.Context, id string, text string) (*schema.JobComment, error) {
+	cid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing comment id")
+		return nil, err
+	}
+
+	return r.Repo.UpdateComment(repository.GetUserFromContext(ctx), cid, text)
+}
+
+// DeleteJobComment is the resolver for the deleteJobComment field.
+func (r *mutationResolver) DeleteJobComment(ctx context.Context, id string) (string, error) {
+	cid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing comment id")
+		return "", err
+	}
+
+	if err := r.Repo.DeleteComment(repository.GetUserFromContext(ctx), cid); err != nil {
+		log.Warn("Error while deleting comment")
+		return "", err
+	}
+
+	return id, nil
+}
+
+// SaveJobFilter is the resolver for the saveJobFilter field.
+func (r *mutationResolver) SaveJobFilter(ctx context.Context, name string, filter []*model.JobFilter, shared *string, project *string) (*model.SavedJobFilter, error) {
+	var sharedWith, projectName string
+	if shared != nil {
+		sharedWith = *shared
+	}
+	if project != nil {
+		projectName = *project
+	}
+
+	return repository.GetSavedFilterRepository().Save(repository.GetUserFromContext(ctx), name, sharedWith, projectName, filter)
+}
+
+// DeleteJobFilter is the resolver for the deleteJobFilter field.
+func (r *mutationResolver) DeleteJobFilter(ctx context.Context, id string) (string, error) {
+	fid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing filter id")
+		return "", err
+	}
+
+	if err := repository.GetSavedFilterRepository().Delete(repository.GetUserFromContext(ctx), fid); err != nil {
+		log.Warn("Error while deleting saved filter")
+		return "", err
+	}
+
+	return id, nil
+}
+
+// CreateJobCollection is the resolver for the createJobCollection field.
+func (r *mutationResolver) CreateJobCollection(ctx context.Context, name string, description *string) (*model.JobCollection, error) {
+	var desc string
+	if description != nil {
+		desc = *description
+	}
+
+	return repository.GetCollectionRepository().Create(repository.GetUserFromContext(ctx), name, desc)
+}
+
+// UpdateJobCollection is the resolver for the updateJobCollection field.
+func (r *mutationResolver) UpdateJobCollection(ctx context.Context, id string, name *string, description *string) (*model.JobCollection, error) {
+	cid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing collection id")
+		return nil, err
+	}
+
+	return repository.GetCollectionRepository().Update(repository.GetUserFromContext(ctx), cid, name, description)
+}
+
+// DeleteJobCollection is the resolver for the deleteJobCollection field.
+func (r *mutationResolver) DeleteJobCollection(ctx context.Context, id string) (string, error) {
+	cid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing collection id")
+		return "", err
+	}
+
+	if err := repository.GetCollectionRepository().Delete(repository.GetUserFromContext(ctx), cid); err != nil {
+		log.Warn("Error while deleting job collection")
+		return "", err
+	}
+
+	return id, nil
+}
+
+// AddJobsToCollection is the resolver for the addJobsToCollection field.
+func (r *mutationResolver) AddJobsToCollection(ctx context.Context, id string, jobIds []string) (*model.JobCollection, error) {
+	cid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing collection id")
+		return nil, err
+	}
+	jids, err := parseJobIds(jobIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return repository.GetCollectionRepository().AddJobs(ctx, cid, jids)
+}
+
+// RemoveJobsFromCollection is the resolver for the removeJobsFromCollection field.
+func (r *mutationResolver) RemoveJobsFromCollection(ctx context.Context, id string, jobIds []string) (*model.JobCollection, error) {
+	cid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing collection id")
+		return nil, err
+	}
+	jids, err := parseJobIds(jobIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return repository.GetCollectionRepository().RemoveJobs(repository.GetUserFromContext(ctx), cid, jids)
+}
+
+// ShareJobCollection is the resolver for the shareJobCollection field.
+func (r *mutationResolver) ShareJobCollection(ctx context.Context, id string, users []string, projects []string) (*model.JobCollection, error) {
+	cid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing collection id")
+		return nil, err
+	}
+
+	return repository.GetCollectionRepository().Share(repository.GetUserFromContext(ctx), cid, users, projects)
+}
+
+// CreateAllocation is the resolver for the createAllocation field.
+func (r *mutationResolver) CreateAllocation(ctx context.Context, allocation model.AllocationInput) (*schema.Allocation, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return repository.GetAllocationRepository().Add(allocationFromInput(allocation))
+}
+
+// UpdateAllocation is the resolver for the updateAllocation field.
+func (r *mutationResolver) UpdateAllocation(ctx context.Context, id string, allocation model.AllocationInput) (*schema.Allocation, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	aid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing allocation id")
+		return nil, err
+	}
+
+	a := allocationFromInput(allocation)
+	a.ID = aid
+	return repository.GetAllocationRepository().Update(a)
+}
+
+// DeleteAllocation is the resolver for the deleteAllocation field.
+func (r *mutationResolver) DeleteAllocation(ctx context.Context, id string) (string, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return "", err
+	}
+
+	aid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing allocation id")
+		return "", err
+	}
+
+	if err := repository.GetAllocationRepository().Delete(aid); err != nil {
+		log.Warn("Error while deleting allocation")
+		return "", err
+	}
+
+	return id, nil
+}
+
 // UpdateConfiguration is the resolver for the updateConfiguration field.
 func (r *mutationResolver) UpdateConfiguration(ctx context.Context, name string, value string) (*string, error) {
 	if err := repository.GetUserCfgRepo().UpdateConfig(name, value, repository.GetUserFromContext(ctx)); err != nil {
@@ -201,6 +444,16 @@ func (r *mutationResolver) UpdateConfiguration(ctx context.Context, name string,
 	return nil, nil
 }
 
+// HealthInfo is the resolver for the healthInfo field.
+func (r *nodeResolver) HealthInfo(ctx context.Context, obj *schema.Node) (any, error) {
+	return obj.HealthInfo, nil
+}
+
+// Inventory is the resolver for the inventory field.
+func (r *nodeResolver) Inventory(ctx context.Context, obj *schema.Node) (any, error) {
+	return obj.Inventory, nil
+}
+
 // Clusters is the resolver for the clusters field.
 func (r *queryResolver) Clusters(ctx context.Context) ([]*schema.Cluster, error) {
 	return archive.Clusters, nil
@@ -240,6 +493,27 @@ func (r *queryResolver) AllocatedNodes(ctx context.Context, cluster string) ([]*
 	return counts, nil
 }
 
+// ClusterUtilization is the resolver for the clusterUtilization field.
+func (r *queryResolver) ClusterUtilization(ctx context.Context, cluster string, subCluster *string, partition *string, from time.Time, to time.Time, bucketSize *int) ([]*model.UtilizationPoint, error) {
+	user := repository.GetUserFromContext(ctx)
+	if user != nil && !user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
+		return nil, errors.New("you need to be administrator or support staff for this query")
+	}
+
+	size := 3600
+	if bucketSize != nil {
+		size = *bucketSize
+	}
+
+	points, err := r.Repo.ClusterUtilization(cluster, subCluster, partition, from, to, size)
+	if err != nil {
+		log.Warn("Error while computing cluster utilization")
+		return nil, err
+	}
+
+	return points, nil
+}
+
 // Job is the resolver for the job field.
 func (r *queryResolver) Job(ctx context.Context, id string) (*schema.Job, error) {
 	numericId, err := strconv.ParseInt(id, 10, 64)
@@ -248,18 +522,14 @@ func (r *queryResolver) Job(ctx context.Context, id string) (*schema.Job, error)
 		return nil, err
 	}
 
+	// Only jobs the user has access to are found, including the jobs of
+	// collections shared with the user
 	job, err := r.Repo.FindById(ctx, numericId)
 	if err != nil {
 		log.Warn("Error while finding job by id")
 		return nil, err
 	}
 
-	if user := repository.GetUserFromContext(ctx); user != nil &&
-		job.User != user.Username &&
-		user.HasNotRoles([]schema.Role{schema.RoleAdmin, schema.RoleSupport, schema.RoleManager}) {
-		return nil, errors.New("you are not allowed to see this job")
-	}
-
 	return job, nil
 }
 
@@ -315,18 +585,35 @@ func (r *queryResolver) Jobs(ctx context.Context, filter []*model.JobFilter, pag
 		}
 	}
 
-	jobs, err := r.Repo.QueryJobs(ctx, filter, page, order)
+	filter, err := expandSavedFilters(ctx, filter)
 	if err != nil {
-		log.Warn("Error while querying jobs")
 		return nil, err
 	}
 
+	// Counting all matching jobs would defeat the purpose of cursor pagination
+	if page.Cursor != nil {
+		jobs, next, prev, err := r.Repo.QueryJobsCursor(ctx, filter, order, page.ItemsPerPage, *page.Cursor)
+		if err != nil {
+			log.Warn("Error while querying jobs")
+			return nil, err
+		}
+
+		hasNextPage := next != nil
+		return &model.JobResultList{Items: jobs, HasNextPage: &hasNextPage, NextCursor: next, PrevCursor: prev}, nil
+	}
+
 	count, err := r.Repo.CountJobs(ctx, filter)
 	if err != nil {
 		log.Warn("Error while counting jobs")
 		return nil, err
 	}
 
+	jobs, err := r.Repo.QueryJobs(ctx, filter, page, order)
+	if err != nil {
+		log.Warn("Error while querying jobs")
+		return nil, err
+	}
+
 	if !config.Keys.UiDefaults["job_list_usePaging"].(bool) {
 		hasNextPage := false
 		// page.Page += 1 		   : Simple, but expensive
@@ -354,7 +641,7 @@ func (r *queryResolver) Jobs(ctx context.Context, filter []*model.JobFilter, pag
 }
 
 // JobsStatistics is the resolver for the jobsStatistics field.
-func (r *queryResolver) JobsStatistics(ctx context.Context, filter []*model.JobFilter, metrics []string, page *model.PageRequest, sortBy *model.SortByAggregate, groupBy *model.Aggregate, numDurationBins *string, numMetricBins *int) ([]*model.JobsStatistics, error) {
+func (r *queryResolver) JobsStatistics(ctx context.Context, filter []*model.JobFilter, metrics []string, page *model.PageRequest, sortBy *model.SortByAggregate, groupBy *model.Aggregate, numDurationBins *string, numMetricBins *int, numWaitTimeBins *string) ([]*model.JobsStatistics, error) {
 	var err error
 	var stats []*model.JobsStatistics
 
@@ -362,6 +649,10 @@ func (r *queryResolver) JobsStatistics(ctx context.Context, filter []*model.JobF
 	var defaultDurationBins string = "1h"
 	var defaultMetricBins int = 10
 
+	if filter, err = expandSavedFilters(ctx, filter); err != nil {
+		return nil, err
+	}
+
 	if requireField(ctx, "totalJobs") || requireField(ctx, "totalWalltime") || requireField(ctx, "totalNodes") || requireField(ctx, "totalCores") ||
 		requireField(ctx, "totalAccs") || requireField(ctx, "totalNodeHours") || requireField(ctx, "totalCoreHours") || requireField(ctx, "totalAccHours") {
 		if groupBy == nil {
@@ -426,6 +717,22 @@ func (r *queryResolver) JobsStatistics(ctx context.Context, filter []*model.JobF
 		}
 	}
 
+	if requireField(ctx, "avgWaitTime") || requireField(ctx, "maxWaitTime") {
+		if stats, err = r.Repo.AddWaitTimes(ctx, filter, groupBy, stats); err != nil {
+			return nil, err
+		}
+	}
+
+	if requireField(ctx, "histWaitTime") {
+		if numWaitTimeBins == nil {
+			numWaitTimeBins = &defaultDurationBins
+		}
+
+		if stats, err = r.Repo.AddWaitTimeHistograms(ctx, filter, groupBy, stats, numWaitTimeBins); err != nil {
+			return nil, err
+		}
+	}
+
 	return stats, nil
 }
 
@@ -542,6 +849,97 @@ func (r *queryResolver) NodeMetricsList(ctx context.Context, cluster string, sub
 	return nodeMetricsListResult, nil
 }
 
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id string) (*schema.Node, error) {
+	user := repository.GetUserFromContext(ctx)
+	if user != nil && !user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
+		return nil, errors.New("you need to be administrator or support staff for this query")
+	}
+
+	numericId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing node id")
+		return nil, err
+	}
+
+	return repository.GetNodeRepository().GetNode(numericId)
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, filter []*model.NodeFilter, order *model.OrderByInput) ([]*schema.Node, error) {
+	user := repository.GetUserFromContext(ctx)
+	if user != nil && !user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
+		return nil, errors.New("you need to be administrator or support staff for this query")
+	}
+
+	return repository.GetNodeRepository().QueryNodes(filter, order)
+}
+
+// NodeStates is the resolver for the nodeStates field.
+func (r *queryResolver) NodeStates(ctx context.Context, cluster string) ([]*model.Count, error) {
+	user := repository.GetUserFromContext(ctx)
+	if user != nil && !user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
+		return nil, errors.New("you need to be administrator or support staff for this query")
+	}
+
+	data, err := repository.GetNodeRepository().CountStates(cluster)
+	if err != nil {
+		log.Warn("Error while counting node states")
+		return nil, err
+	}
+
+	counts := make([]*model.Count, 0, len(data))
+	for state, count := range data {
+		counts = append(counts, &model.Count{
+			Name:  string(state),
+			Count: count,
+		})
+	}
+
+	return counts, nil
+}
+
+// NodeStateHistory is the resolver for the nodeStateHistory field.
+func (r *queryResolver) NodeStateHistory(ctx context.Context, cluster string, hostname string, from time.Time, to time.Time) ([]*schema.NodeStateChange, error) {
+	user := repository.GetUserFromContext(ctx)
+	if user != nil && !user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
+		return nil, errors.New("you need to be administrator or support staff for this query")
+	}
+
+	return repository.GetNodeRepository().NodeStateHistory(cluster, hostname, from.Unix(), to.Unix())
+}
+
+// SavedJobFilters is the resolver for the savedJobFilters field.
+func (r *queryResolver) SavedJobFilters(ctx context.Context) ([]*model.SavedJobFilter, error) {
+	return repository.GetSavedFilterRepository().List(repository.GetUserFromContext(ctx))
+}
+
+// JobCollections is the resolver for the jobCollections field.
+func (r *queryResolver) JobCollections(ctx context.Context) ([]*model.JobCollection, error) {
+	return repository.GetCollectionRepository().List(repository.GetUserFromContext(ctx))
+}
+
+// JobCollection is the resolver for the jobCollection field.
+func (r *queryResolver) JobCollection(ctx context.Context, id string) (*model.JobCollection, error) {
+	cid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		log.Warn("Error while parsing collection id")
+		return nil, err
+	}
+
+	return repository.GetCollectionRepository().Get(repository.GetUserFromContext(ctx), cid)
+}
+
+// Allocations is the resolver for the allocations field.
+func (r *queryResolver) Allocations(ctx context.Context, project *string, cluster *string, activeAt *time.Time) ([]*schema.Allocation, error) {
+	return repository.GetAllocationRepository().List(repository.GetUserFromContext(ctx), project, cluster, activeAt)
+}
+
+// Filter is the resolver for the filter field.
+func (r *savedJobFilterResolver) Filter(ctx context.Context, obj *model.SavedJobFilter) (any, error) {
+	return obj.Filter, nil
+}
+
 // NumberOfNodes is the resolver for the numberOfNodes field.
 func (r *subClusterResolver) NumberOfNodes(ctx context.Context, obj *schema.SubCluster) (int, error) {
 	nodeList, err := archive.ParseNodeList(obj.Nodes)
@@ -551,27 +949,45 @@ func (r *subClusterResolver) NumberOfNodes(ctx context.Context, obj *schema.SubC
 	return nodeList.NodeCount(), nil
 }
 
+// Allocation returns generated.AllocationResolver implementation.
+func (r *Resolver) Allocation() generated.AllocationResolver { return &allocationResolver{r} }
+
 // Cluster returns generated.ClusterResolver implementation.
 func (r *Resolver) Cluster() generated.ClusterResolver { return &clusterResolver{r} }
 
 // Job returns generated.JobResolver implementation.
 func (r *Resolver) Job() generated.JobResolver { return &jobResolver{r} }
 
+// JobEvent returns generated.JobEventResolver implementation.
+func (r *Resolver) JobEvent() generated.JobEventResolver { return &jobEventResolver{r} }
+
 // MetricValue returns generated.MetricValueResolver implementation.
 func (r *Resolver) MetricValue() generated.MetricValueResolver { return &metricValueResolver{r} }
 
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
+// Node returns generated.NodeResolver implementation.
+func (r *Resolver) Node() generated.NodeResolver { return &nodeResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// SavedJobFilter returns generated.SavedJobFilterResolver implementation.
+func (r *Resolver) SavedJobFilter() generated.SavedJobFilterResolver {
+	return &savedJobFilterResolver{r}
+}
+
 // SubCluster returns generated.SubClusterResolver implementation.
 func (r *Resolver) SubCluster() generated.SubClusterResolver { return &subClusterResolver{r} }
 
+type allocationResolver struct{ *Resolver }
 type clusterResolver struct{ *Resolver }
 type jobResolver struct{ *Resolver }
+type jobEventResolver struct{ *Resolver }
 type metricValueResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type nodeResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type savedJobFilterResolver struct{ *Resolver }
 type subClusterResolver struct{ *Resolver }
@@ -6,12 +6,15 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
 	"github.com/ClusterCockpit/cc-backend/internal/metricDataDispatcher"
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 	// "github.com/ClusterCockpit/cc-backend/pkg/archive"
@@ -26,6 +29,11 @@ func (r *queryResolver) rooflineHeatmap(
 	rows int, cols int,
 	minX float64, minY float64, maxX float64, maxY float64,
 ) ([][]float64, error) {
+	filter, err := expandSavedFilters(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
 	jobs, err := r.Repo.QueryJobs(ctx, filter, &model.PageRequest{Page: 1, ItemsPerPage: MAX_JOBS_FOR_ANALYSIS + 1}, nil)
 	if err != nil {
 		log.Error("Error while querying jobs for roofline")
@@ -103,6 +111,11 @@ func (r *queryResolver) rooflineHeatmap(
 
 // Helper function for the jobsFootprints GraphQL query placed here so that schema.resolvers.go is not too full.
 func (r *queryResolver) jobsFootprints(ctx context.Context, filter []*model.JobFilter, metrics []string) (*model.Footprints, error) {
+	filter, err := expandSavedFilters(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
 	jobs, err := r.Repo.QueryJobs(ctx, filter, &model.PageRequest{Page: 1, ItemsPerPage: MAX_JOBS_FOR_ANALYSIS + 1}, nil)
 	if err != nil {
 		log.Error("Error while querying jobs for footprint")
@@ -183,6 +196,59 @@ func (r *queryResolver) jobsFootprints(ctx context.Context, filter []*model.JobF
 // 	return totalJobCores
 // }
 
+// Replace references to saved job filters by the stored filters. Filters by
+// job collections are checked as well, also if they are part of a saved
+// filter, so that only visible collections can be queried.
+func expandSavedFilters(ctx context.Context, filter []*model.JobFilter) ([]*model.JobFilter, error) {
+	user := repository.GetUserFromContext(ctx)
+	filter, err := repository.GetSavedFilterRepository().Expand(user, filter)
+	if err != nil {
+		log.Warn("Error while expanding saved filters")
+		return nil, err
+	}
+
+	if err := repository.GetCollectionRepository().CheckFilter(user, filter); err != nil {
+		log.Warn("Error while checking job collection filters")
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func parseJobIds(ids []string) ([]int64, error) {
+	jids := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		jid, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			log.Warn("Error while parsing job id")
+			return nil, err
+		}
+		jids = append(jids, jid)
+	}
+
+	return jids, nil
+}
+
+func requireAdmin(ctx context.Context) error {
+	user := repository.GetUserFromContext(ctx)
+	if user != nil && !user.HasRole(schema.RoleAdmin) {
+		return errors.New("you need to be administrator for this query")
+	}
+	return nil
+}
+
+func allocationFromInput(input model.AllocationInput) *schema.Allocation {
+	return &schema.Allocation{
+		Project:   input.Project,
+		Cluster:   input.Cluster,
+		StartTime: input.StartTime,
+		EndTime:   input.EndTime,
+		CoreHours: input.CoreHours,
+		AccHours:  input.AccHours,
+		Energy:    input.Energy,
+	}
+}
+
 func requireField(ctx context.Context, name string) bool {
 	fields := graphql.CollectAllFields(ctx)
 
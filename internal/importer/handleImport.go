@@ -21,142 +21,167 @@ import (
 
 // Import all jobs specified as `<path-to-meta.json>:<path-to-data.json>,...`
 func HandleImportFlag(flag string) error {
-	r := repository.GetJobRepository()
-
 	for _, pair := range strings.Split(flag, ",") {
 		files := strings.Split(pair, ":")
 		if len(files) != 2 {
 			return fmt.Errorf("REPOSITORY/INIT > invalid import flag format")
 		}
 
-		raw, err := os.ReadFile(files[0])
+		rawMeta, err := os.ReadFile(files[0])
 		if err != nil {
 			log.Warn("Error while reading metadata file for import")
 			return err
 		}
 
-		if config.Keys.Validate {
-			if err = schema.Validate(schema.Meta, bytes.NewReader(raw)); err != nil {
-				return fmt.Errorf("REPOSITORY/INIT > validate job meta: %v", err)
-			}
-		}
-		dec := json.NewDecoder(bytes.NewReader(raw))
-		dec.DisallowUnknownFields()
-		job := schema.JobMeta{BaseJob: schema.JobDefaults}
-		if err = dec.Decode(&job); err != nil {
-			log.Warn("Error while decoding raw json metadata for import")
+		rawData, err := os.ReadFile(files[1])
+		if err != nil {
+			log.Warn("Error while reading jobdata file for import")
 			return err
 		}
 
-		raw, err = os.ReadFile(files[1])
+		job, jobData, err := DecodeJob(rawMeta, rawData, config.Keys.Validate)
 		if err != nil {
-			log.Warn("Error while reading jobdata file for import")
 			return err
 		}
 
-		if config.Keys.Validate {
-			if err = schema.Validate(schema.Data, bytes.NewReader(raw)); err != nil {
-				return fmt.Errorf("REPOSITORY/INIT > validate job data: %v", err)
-			}
-		}
-		dec = json.NewDecoder(bytes.NewReader(raw))
-		dec.DisallowUnknownFields()
-		jobData := schema.JobData{}
-		if err = dec.Decode(&jobData); err != nil {
-			log.Warn("Error while decoding raw json jobdata for import")
+		if _, err := ImportJob(job, jobData); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		job.MonitoringStatus = schema.MonitoringStatusArchivingSuccessful
+// DecodeJob decodes the meta.json and data.json of a job to import and
+// checks the job with SanityChecks. If `validate` is set, both are validated
+// against the JSON schemas first.
+func DecodeJob(rawMeta, rawData []byte, validate bool) (*schema.JobMeta, *schema.JobData, error) {
+	if validate {
+		if err := schema.Validate(schema.Meta, bytes.NewReader(rawMeta)); err != nil {
+			return nil, nil, fmt.Errorf("REPOSITORY/INIT > validate job meta: %v", err)
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(rawMeta))
+	dec.DisallowUnknownFields()
+	job := schema.JobMeta{BaseJob: schema.JobDefaults}
+	if err := dec.Decode(&job); err != nil {
+		log.Warn("Error while decoding raw json metadata for import")
+		return nil, nil, err
+	}
 
-		sc, err := archive.GetSubCluster(job.Cluster, job.SubCluster)
-		if err != nil {
-			log.Errorf("cannot get subcluster: %s", err.Error())
-			return err
+	if validate {
+		if err := schema.Validate(schema.Data, bytes.NewReader(rawData)); err != nil {
+			return nil, nil, fmt.Errorf("REPOSITORY/INIT > validate job data: %v", err)
 		}
+	}
+	dec = json.NewDecoder(bytes.NewReader(rawData))
+	dec.DisallowUnknownFields()
+	jobData := schema.JobData{}
+	if err := dec.Decode(&jobData); err != nil {
+		log.Warn("Error while decoding raw json jobdata for import")
+		return nil, nil, err
+	}
 
-		job.Footprint = make(map[string]float64)
+	if err := SanityChecks(&job.BaseJob); err != nil {
+		log.Warn("BaseJob SanityChecks failed")
+		return nil, nil, err
+	}
 
-		for _, fp := range sc.Footprint {
-			statType := "avg"
+	return &job, &jobData, nil
+}
 
-			if i, err := archive.MetricIndex(sc.MetricConfig, fp); err != nil {
-				statType = sc.MetricConfig[i].Footprint
-			}
+// ImportJob stores a decoded job in the job archive and inserts it with its
+// tags and comments into the database. Returns the database id of the job.
+func ImportJob(job *schema.JobMeta, jobData *schema.JobData) (int64, error) {
+	r := repository.GetJobRepository()
+	job.MonitoringStatus = schema.MonitoringStatusArchivingSuccessful
 
-			name := fmt.Sprintf("%s_%s", fp, statType)
+	sc, err := archive.GetSubCluster(job.Cluster, job.SubCluster)
+	if err != nil {
+		log.Errorf("cannot get subcluster: %s", err.Error())
+		return 0, err
+	}
 
-			job.Footprint[name] = repository.LoadJobStat(&job, fp, statType)
-		}
+	job.Footprint = make(map[string]float64)
 
-		job.RawFootprint, err = json.Marshal(job.Footprint)
-		if err != nil {
-			log.Warn("Error while marshaling job footprint")
-			return err
+	for _, fp := range sc.Footprint {
+		statType := "avg"
+
+		if i, err := archive.MetricIndex(sc.MetricConfig, fp); err != nil {
+			statType = sc.MetricConfig[i].Footprint
 		}
 
-		job.EnergyFootprint = make(map[string]float64)
-		var totalEnergy float64
-		var energy float64
-
-		for _, fp := range sc.EnergyFootprint {
-			if i, err := archive.MetricIndex(sc.MetricConfig, fp); err == nil {
-				// Note: For DB data, calculate and save as kWh
-				// Energy: Power (in Watts) * Time (in Seconds)
-				if sc.MetricConfig[i].Energy == "energy" { // this metric has energy as unit (Joules)
-				} else if sc.MetricConfig[i].Energy == "power" { // this metric has power as unit (Watt)
-					// Unit: ( W * s ) / 3600 / 1000 = kWh ; Rounded to 2 nearest digits
-					energy = math.Round(((repository.LoadJobStat(&job, fp, "avg")*float64(job.Duration))/3600/1000)*100) / 100
-				}
-			} else {
-				log.Warnf("Error while collecting energy metric %s for job, DB ID '%v', return '0.0'", fp, job.ID)
-			}
+		name := fmt.Sprintf("%s_%s", fp, statType)
 
-			job.EnergyFootprint[fp] = energy
-			totalEnergy += energy
-		}
+		job.Footprint[name] = repository.LoadJobStat(job, fp, statType)
+	}
 
-		job.Energy = (math.Round(totalEnergy*100) / 100)
-		if job.RawEnergyFootprint, err = json.Marshal(job.EnergyFootprint); err != nil {
-			log.Warnf("Error while marshaling energy footprint for job INTO BYTES, DB ID '%v'", job.ID)
-			return err
-		}
+	job.RawFootprint, err = json.Marshal(job.Footprint)
+	if err != nil {
+		log.Warn("Error while marshaling job footprint")
+		return 0, err
+	}
 
-		job.RawResources, err = json.Marshal(job.Resources)
-		if err != nil {
-			log.Warn("Error while marshaling job resources")
-			return err
-		}
-		job.RawMetaData, err = json.Marshal(job.MetaData)
-		if err != nil {
-			log.Warn("Error while marshaling job metadata")
-			return err
+	job.EnergyFootprint = make(map[string]float64)
+	var totalEnergy float64
+	var energy float64
+
+	for _, fp := range sc.EnergyFootprint {
+		if i, err := archive.MetricIndex(sc.MetricConfig, fp); err == nil {
+			// Note: For DB data, calculate and save as kWh
+			// Energy: Power (in Watts) * Time (in Seconds)
+			if sc.MetricConfig[i].Energy == "energy" { // this metric has energy as unit (Joules)
+			} else if sc.MetricConfig[i].Energy == "power" { // this metric has power as unit (Watt)
+				// Unit: ( W * s ) / 3600 / 1000 = kWh ; Rounded to 2 nearest digits
+				energy = math.Round(((repository.LoadJobStat(job, fp, "avg")*float64(job.Duration))/3600/1000)*100) / 100
+			}
+		} else {
+			log.Warnf("Error while collecting energy metric %s for job, DB ID '%v', return '0.0'", fp, job.ID)
 		}
 
-		if err = SanityChecks(&job.BaseJob); err != nil {
-			log.Warn("BaseJob SanityChecks failed")
-			return err
-		}
+		job.EnergyFootprint[fp] = energy
+		totalEnergy += energy
+	}
 
-		if err = archive.GetHandle().ImportJob(&job, &jobData); err != nil {
-			log.Error("Error while importing job")
-			return err
-		}
+	job.Energy = (math.Round(totalEnergy*100) / 100)
+	if job.RawEnergyFootprint, err = json.Marshal(job.EnergyFootprint); err != nil {
+		log.Warnf("Error while marshaling energy footprint for job INTO BYTES, DB ID '%v'", job.ID)
+		return 0, err
+	}
 
-		id, err := r.InsertJob(&job)
-		if err != nil {
-			log.Warn("Error while job db insert")
-			return err
-		}
+	job.RawResources, err = json.Marshal(job.Resources)
+	if err != nil {
+		log.Warn("Error while marshaling job resources")
+		return 0, err
+	}
+	job.RawMetaData, err = json.Marshal(job.MetaData)
+	if err != nil {
+		log.Warn("Error while marshaling job metadata")
+		return 0, err
+	}
 
-		for _, tag := range job.Tags {
-			if err := r.ImportTag(id, tag.Type, tag.Name, tag.Scope); err != nil {
-				log.Error("Error while adding or creating tag on import")
-				return err
-			}
+	if err = archive.GetHandle().ImportJob(job, jobData); err != nil {
+		log.Error("Error while importing job")
+		return 0, err
+	}
+
+	id, err := r.InsertJob(job)
+	if err != nil {
+		log.Warn("Error while job db insert")
+		return 0, err
+	}
+
+	for _, tag := range job.Tags {
+		if err := r.ImportTag(id, tag.Type, tag.Name, tag.Scope); err != nil {
+			log.Error("Error while adding or creating tag on import")
+			return 0, err
 		}
+	}
 
-		log.Infof("successfully imported a new job (jobId: %d, cluster: %s, dbid: %d)", job.JobID, job.Cluster, id)
+	if err := r.ImportComments(id, job.Comments); err != nil {
+		log.Error("Error while adding comments on import")
+		return 0, err
 	}
-	return nil
+
+	log.Infof("successfully imported a new job (jobId: %d, cluster: %s, dbid: %d)", job.JobID, job.Cluster, id)
+	return id, nil
 }
@@ -147,11 +147,15 @@ func InitDB() error {
 			continue
 		}
 
+		if err := r.TransactionUpdateSearchIndex(t, id, job.MetaData); err != nil {
+			log.Errorf("repository initDB(): %v", err)
+		}
+
 		for _, tag := range job.Tags {
 			tagstr := tag.Name + ":" + tag.Type
 			tagId, ok := tags[tagstr]
 			if !ok {
-				tagId, err = r.TransactionAdd(t,
+				tagId, err = r.TransactionInsert(t,
 					addTagQuery,
 					tag.Name, tag.Type)
 				if err != nil {
@@ -167,6 +171,12 @@ func InitDB() error {
 				id, tagId)
 		}
 
+		if err := r.TransactionImportComments(t, id, job.Comments); err != nil {
+			log.Errorf("Error adding comments: %v", err)
+			errorOccured++
+			continue
+		}
+
 		if err == nil {
 			i += 1
 		}
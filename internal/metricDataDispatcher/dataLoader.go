@@ -33,6 +33,32 @@ func cacheKey(
 		job.ID, job.State, metrics, scopes, resolution)
 }
 
+// The disk cache survives restarts and the database ids of jobs can change,
+// e.g. if the database is initialized from the job archive again. Jobs are
+// identified as in the job archive instead. All entries of a job are in the
+// same group.
+func diskCacheGroup(job *schema.Job) string {
+	return fmt.Sprintf("%s/%d/%d", job.Cluster, job.JobID, job.StartTime.Unix())
+}
+
+func diskCacheKey(
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	resolution int,
+) string {
+	return fmt.Sprintf("%s:[%v],[%v]-%d",
+		diskCacheGroup(job), metrics, scopes, resolution)
+}
+
+// Remove the metric data of a job from the disk cache, e.g. when the job is
+// purged. A job with the same id and start time can be imported again later.
+func InvalidateDiskCache(job *schema.Job) {
+	if diskCache != nil {
+		diskCache.Invalidate(diskCacheGroup(job))
+	}
+}
+
 // Fetches the metric data for a job.
 func LoadData(job *schema.Job,
 	metrics []string,
@@ -40,13 +66,27 @@ func LoadData(job *schema.Job,
 	ctx context.Context,
 	resolution int,
 ) (schema.JobData, error) {
-	data := cache.Get(cacheKey(job, metrics, scopes, resolution), func() (_ interface{}, ttl time.Duration, size int) {
+	key := cacheKey(job, metrics, scopes, resolution)
+	data := cache.Get(key, func() (_ interface{}, ttl time.Duration, size int) {
 		var jd schema.JobData
 		var err error
 
-		if job.State == schema.JobStateRunning ||
+		fromMetricRepo := job.State == schema.JobStateRunning ||
 			job.MonitoringStatus == schema.MonitoringStatusRunningOrArchiving ||
-			config.Keys.DisableArchive {
+			config.Keys.DisableArchive
+
+		// Data of running jobs changes and jobs being archived are loaded
+		// from the metric data repository, only archived jobs use the disk
+		// cache.
+		useDiskCache := diskCache != nil && !fromMetricRepo
+		diskGroup, diskKey := diskCacheGroup(job), diskCacheKey(job, metrics, scopes, resolution)
+		if useDiskCache {
+			if jd, ok := diskCache.Get(diskGroup, diskKey); ok {
+				return jd, 5 * time.Hour, jd.Size()
+			}
+		}
+
+		if fromMetricRepo {
 
 			repo, err := metricdata.GetMetricDataRepo(job.Cluster)
 			if err != nil {
@@ -77,7 +117,7 @@ func LoadData(job *schema.Job,
 			size = jd.Size()
 		} else {
 			var jd_temp schema.JobData
-			jd_temp, err = archive.GetHandle().LoadJobData(job)
+			jd_temp, err = archive.GetHandle().LoadJobData(job, resolution)
 			if err != nil {
 				log.Error("Error while loading job data from archive")
 				return err, 0, 0
@@ -90,6 +130,11 @@ func LoadData(job *schema.Job,
 			//Pass the resolution from frontend here.
 			for _, v := range jd {
 				for _, v_ := range v {
+					if v_.Timestep != 0 && resolution%v_.Timestep != 0 {
+						// Archived data may be downsampled to a timestep
+						// the requested resolution is no multiple of
+						continue
+					}
 					timestep := 0
 					for i := 0; i < len(v_.Series); i += 1 {
 						v_.Series[i].Data, timestep, err = resampler.LargestTriangleThreeBucket(v_.Series[i].Data, v_.Timestep, resolution)
@@ -170,6 +215,12 @@ func LoadData(job *schema.Job,
 			jd.AddNodeScope("mem_bw")
 		}
 
+		if useDiskCache {
+			if err := diskCache.Put(diskGroup, diskKey, jd); err != nil {
+				log.Warnf("Error while storing job %d in metric data disk cache: %s", job.ID, err.Error())
+			}
+		}
+
 		return jd, ttl, size
 	})
 
@@ -0,0 +1,403 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricDataDispatcher
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Every cache file starts with this header followed by the gob encoded
+// schema.JobData:
+//
+//	magic (4 byte) | expiration (8 byte, unix seconds) | sha256 of payload (32 byte)
+const (
+	diskCacheMagic      = "CCD1"
+	diskCacheHeaderSize = 4 + 8 + sha256.Size
+	diskCacheSuffix     = ".cache"
+	diskCacheTmpPrefix  = "tmp-"
+)
+
+var (
+	diskCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cc_backend_metric_data_disk_cache_hits_total",
+		Help: "Number of metric data requests served from the disk cache",
+	})
+	diskCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cc_backend_metric_data_disk_cache_misses_total",
+		Help: "Number of metric data requests not found in the disk cache",
+	})
+	diskCacheEvictions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cc_backend_metric_data_disk_cache_evictions_total",
+		Help: "Number of disk cache entries removed to stay below the size limit",
+	})
+	diskCacheCorrupted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cc_backend_metric_data_disk_cache_corrupted_total",
+		Help: "Number of disk cache entries discarded because they failed verification",
+	})
+	diskCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "cc_backend_metric_data_disk_cache_size_bytes",
+		Help: "Current size of the metric data disk cache",
+	})
+	diskCacheEntries = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "cc_backend_metric_data_disk_cache_entries",
+		Help: "Current number of entries in the metric data disk cache",
+	})
+)
+
+type diskCacheEntry struct {
+	name string
+	size int64
+}
+
+// A size bounded second level cache for decoded metric data which survives
+// restarts. Entries are evicted in LRU order, the order is kept across
+// restarts using the modification time of the cache files.
+type DiskCache struct {
+	mutex     sync.Mutex
+	path      string
+	maxSize   int64
+	usedSize  int64
+	ttl       time.Duration
+	entries   map[string]*list.Element
+	lru       *list.List
+	writeLock map[string]bool
+}
+
+// Only set if a disk cache is configured.
+var diskCache *DiskCache
+
+// Initialize the disk cache from the `metric-data-cache` configuration.
+// Does nothing if cfg is nil.
+func InitDiskCache(cfg *schema.DiskCacheConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	ttl := 168 * time.Hour
+	if cfg.TTL != "" {
+		d, err := time.ParseDuration(cfg.TTL)
+		if err != nil {
+			log.Warnf("Could not parse metric data cache ttl '%s'", cfg.TTL)
+			return err
+		}
+		ttl = d
+	}
+
+	dc, err := NewDiskCache(cfg.Path, int64(cfg.MaxSize)*1024*1024, ttl)
+	if err != nil {
+		return err
+	}
+
+	diskCache = dc
+	return nil
+}
+
+// Return a new disk cache using directory path, limited to maxSize bytes.
+// Existing entries in path are picked up, temporary files left over by an
+// interrupted Put are removed.
+func NewDiskCache(path string, maxSize int64, ttl time.Duration) (*DiskCache, error) {
+	if path == "" || maxSize <= 0 {
+		return nil, fmt.Errorf("METRICDATA/DISKCACHE > path and positive max-size required")
+	}
+
+	if err := os.MkdirAll(path, 0o750); err != nil {
+		log.Errorf("Error while creating metric data cache directory: %v", err)
+		return nil, err
+	}
+
+	dc := &DiskCache{
+		path:      path,
+		maxSize:   maxSize,
+		ttl:       ttl,
+		entries:   make(map[string]*list.Element),
+		lru:       list.New(),
+		writeLock: make(map[string]bool),
+	}
+
+	dirEntries, err := os.ReadDir(path)
+	if err != nil {
+		log.Errorf("Error while reading metric data cache directory: %v", err)
+		return nil, err
+	}
+
+	type fileInfo struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	files := make([]fileInfo, 0, len(dirEntries))
+	for _, de := range dirEntries {
+		if !de.IsDir() && strings.HasPrefix(de.Name(), diskCacheTmpPrefix) {
+			if err := os.Remove(filepath.Join(path, de.Name())); err != nil {
+				log.Warnf("Error while removing metric data cache file: %v", err)
+			}
+			continue
+		}
+		if de.IsDir() || !strings.HasSuffix(de.Name(), diskCacheSuffix) {
+			continue
+		}
+		info, err := de.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, fileInfo{name: de.Name(), size: info.Size(), modTime: info.ModTime()})
+	}
+
+	// Most recently used first
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.After(files[j].modTime)
+	})
+
+	for _, f := range files {
+		dc.entries[f.name] = dc.lru.PushBack(&diskCacheEntry{name: f.name, size: f.size})
+		dc.usedSize += f.size
+	}
+
+	dc.mutex.Lock()
+	dc.evict(0)
+	dc.updateGauges()
+	dc.mutex.Unlock()
+
+	log.Infof("Metric data disk cache at %s: %d entries, %d bytes", path, dc.lru.Len(), dc.usedSize)
+	return dc, nil
+}
+
+// The filename starts with a hash of the group, so that all entries of a
+// group can be found without knowing their keys.
+func diskCacheFilename(group, key string) string {
+	return diskCacheGroupPrefix(group) + hex.EncodeToString(hashOf(key)) + diskCacheSuffix
+}
+
+func diskCacheGroupPrefix(group string) string {
+	return hex.EncodeToString(hashOf(group)[:8]) + "-"
+}
+
+func hashOf(s string) []byte {
+	sum := sha256.Sum256([]byte(s))
+	return sum[:]
+}
+
+// Return the cached job data for key in group, or false if there is no
+// valid entry. Expired and corrupted entries are removed.
+func (dc *DiskCache) Get(group, key string) (schema.JobData, bool) {
+	name := diskCacheFilename(group, key)
+
+	dc.mutex.Lock()
+	_, ok := dc.entries[name]
+	dc.mutex.Unlock()
+	if !ok {
+		diskCacheMisses.Inc()
+		return nil, false
+	}
+
+	jd, err := dc.read(name)
+	if err != nil {
+		if errors.Is(err, errDiskCacheExpired) || errors.Is(err, os.ErrNotExist) {
+			// Entries can be evicted or invalidated while being looked up
+			diskCacheMisses.Inc()
+		} else {
+			log.Warnf("Discard metric data cache entry for '%s': %s", key, err.Error())
+			diskCacheCorrupted.Inc()
+		}
+		dc.remove(name)
+		return nil, false
+	}
+
+	now := time.Now()
+	os.Chtimes(filepath.Join(dc.path, name), now, now)
+
+	dc.mutex.Lock()
+	if e, ok := dc.entries[name]; ok {
+		dc.lru.MoveToFront(e)
+	}
+	dc.mutex.Unlock()
+
+	diskCacheHits.Inc()
+	return jd, true
+}
+
+// Store job data under key in group. Entries are written to a temporary file
+// first so that readers never see partially written entries.
+func (dc *DiskCache) Put(group, key string, jd schema.JobData) error {
+	name := diskCacheFilename(group, key)
+
+	dc.mutex.Lock()
+	if dc.writeLock[name] {
+		// Someone else is storing the same entry right now
+		dc.mutex.Unlock()
+		return nil
+	}
+	dc.writeLock[name] = true
+	dc.mutex.Unlock()
+
+	defer func() {
+		dc.mutex.Lock()
+		delete(dc.writeLock, name)
+		dc.mutex.Unlock()
+	}()
+
+	var payload bytes.Buffer
+	if err := gob.NewEncoder(&payload).Encode(jd); err != nil {
+		log.Warn("Error while encoding metric data for disk cache")
+		return err
+	}
+
+	size := int64(diskCacheHeaderSize + payload.Len())
+	if size > dc.maxSize {
+		return nil
+	}
+
+	header := make([]byte, 0, diskCacheHeaderSize)
+	header = append(header, diskCacheMagic...)
+	header = binary.BigEndian.AppendUint64(header, uint64(time.Now().Add(dc.ttl).Unix()))
+	sum := sha256.Sum256(payload.Bytes())
+	header = append(header, sum[:]...)
+
+	f, err := os.CreateTemp(dc.path, diskCacheTmpPrefix+"*")
+	if err != nil {
+		log.Errorf("Error while creating metric data cache file: %v", err)
+		return err
+	}
+	if _, err := f.Write(header); err == nil {
+		_, err = payload.WriteTo(f)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		log.Errorf("Error while writing metric data cache file: %v", err)
+		return err
+	}
+
+	dc.mutex.Lock()
+	defer dc.mutex.Unlock()
+
+	if e, ok := dc.entries[name]; ok {
+		dc.usedSize -= e.Value.(*diskCacheEntry).size
+		dc.lru.Remove(e)
+		delete(dc.entries, name)
+	}
+	dc.evict(size)
+
+	if err := os.Rename(f.Name(), filepath.Join(dc.path, name)); err != nil {
+		os.Remove(f.Name())
+		log.Errorf("Error while storing metric data cache file: %v", err)
+		dc.updateGauges()
+		return err
+	}
+
+	dc.entries[name] = dc.lru.PushFront(&diskCacheEntry{name: name, size: size})
+	dc.usedSize += size
+	dc.updateGauges()
+	return nil
+}
+
+var errDiskCacheExpired = errors.New("METRICDATA/DISKCACHE > entry expired")
+
+func (dc *DiskCache) read(name string) (schema.JobData, error) {
+	b, err := os.ReadFile(filepath.Join(dc.path, name))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < diskCacheHeaderSize || string(b[:4]) != diskCacheMagic {
+		return nil, fmt.Errorf("METRICDATA/DISKCACHE > invalid header")
+	}
+
+	expiration := time.Unix(int64(binary.BigEndian.Uint64(b[4:12])), 0)
+	if time.Now().After(expiration) {
+		return nil, errDiskCacheExpired
+	}
+
+	payload := b[diskCacheHeaderSize:]
+	if sum := sha256.Sum256(payload); !bytes.Equal(sum[:], b[12:diskCacheHeaderSize]) {
+		return nil, fmt.Errorf("METRICDATA/DISKCACHE > checksum mismatch")
+	}
+
+	var jd schema.JobData
+	if err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&jd); err != nil {
+		return nil, err
+	}
+
+	return jd, nil
+}
+
+// Remove all entries of group.
+func (dc *DiskCache) Invalidate(group string) {
+	prefix := diskCacheGroupPrefix(group)
+
+	dc.mutex.Lock()
+	names := make([]string, 0)
+	for name := range dc.entries {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	dc.mutex.Unlock()
+
+	for _, name := range names {
+		dc.remove(name)
+	}
+}
+
+func (dc *DiskCache) remove(name string) {
+	dc.mutex.Lock()
+	defer dc.mutex.Unlock()
+
+	if e, ok := dc.entries[name]; ok {
+		dc.usedSize -= e.Value.(*diskCacheEntry).size
+		dc.lru.Remove(e)
+		delete(dc.entries, name)
+	}
+	if err := os.Remove(filepath.Join(dc.path, name)); err != nil && !os.IsNotExist(err) {
+		log.Warnf("Error while removing metric data cache file: %v", err)
+	}
+	dc.updateGauges()
+}
+
+// Remove least recently used entries until there is room for another
+// additional bytes. Must be called with the mutex held.
+func (dc *DiskCache) evict(additional int64) {
+	for dc.usedSize+additional > dc.maxSize {
+		e := dc.lru.Back()
+		if e == nil {
+			return
+		}
+
+		entry := e.Value.(*diskCacheEntry)
+		if err := os.Remove(filepath.Join(dc.path, entry.name)); err != nil && !os.IsNotExist(err) {
+			log.Warnf("Error while evicting metric data cache file: %v", err)
+		}
+		dc.usedSize -= entry.size
+		dc.lru.Remove(e)
+		delete(dc.entries, entry.name)
+		diskCacheEvictions.Inc()
+	}
+}
+
+// Must be called with the mutex held.
+func (dc *DiskCache) updateGauges() {
+	diskCacheSize.Set(float64(dc.usedSize))
+	diskCacheEntries.Set(float64(dc.lru.Len()))
+}
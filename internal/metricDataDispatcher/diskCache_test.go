@@ -0,0 +1,211 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricDataDispatcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func testJobData(n int) schema.JobData {
+	data := make([]schema.Float, n)
+	for i := range data {
+		data[i] = schema.Float(i)
+	}
+	data[0] = schema.NaN
+
+	return schema.JobData{
+		"flops_any": {
+			schema.MetricScopeNode: &schema.JobMetric{
+				Unit:     schema.Unit{Base: "F/s", Prefix: "G"},
+				Timestep: 60,
+				Series: []schema.Series{{
+					Hostname:   "e0101",
+					Data:       data,
+					Statistics: schema.MetricStatistics{Avg: 1, Min: 0, Max: 2},
+				}},
+			},
+		},
+	}
+}
+
+func TestDiskCachePutGet(t *testing.T) {
+	dir := t.TempDir()
+	dc, err := NewDiskCache(dir, 1024*1024, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := dc.Get("job1", "metrics"); ok {
+		t.Fatal("unexpected hit on empty cache")
+	}
+	if err := dc.Put("job1", "metrics", testJobData(100)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Entries survive a restart
+	dc, err = NewDiskCache(dir, 1024*1024, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jd, ok := dc.Get("job1", "metrics")
+	if !ok {
+		t.Fatal("expected hit after restart")
+	}
+
+	jm := jd["flops_any"][schema.MetricScopeNode]
+	if jm.Timestep != 60 || len(jm.Series[0].Data) != 100 || !jm.Series[0].Data[0].IsNaN() {
+		t.Errorf("unexpected cached data: %#v", jm)
+	}
+}
+
+func TestDiskCacheTmpFiles(t *testing.T) {
+	dir := t.TempDir()
+	tmp := filepath.Join(dir, "tmp-123")
+	if err := os.WriteFile(tmp, []byte("partial"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	// Left over by an interrupted Put
+	if _, err := NewDiskCache(dir, 1024*1024, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(tmp); !os.IsNotExist(err) {
+		t.Errorf("expected %s to be removed: %v", tmp, err)
+	}
+}
+
+func TestDiskCacheEviction(t *testing.T) {
+	dc, err := NewDiskCache(t.TempDir(), 2000, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"job1", "job2", "job3"} {
+		if err := dc.Put(key, "metrics", testJobData(100)); err != nil {
+			t.Fatal(err)
+		}
+		if key == "job2" {
+			// Make job1 the most recently used entry
+			dc.Get("job1", "metrics")
+		}
+	}
+
+	if dc.usedSize > dc.maxSize {
+		t.Errorf("cache exceeds max size: %d > %d", dc.usedSize, dc.maxSize)
+	}
+	if _, ok := dc.Get("job2", "metrics"); ok {
+		t.Error("expected least recently used entry to be evicted")
+	}
+	if _, ok := dc.Get("job3", "metrics"); !ok {
+		t.Error("expected newest entry to be cached")
+	}
+}
+
+func TestDiskCacheCorruption(t *testing.T) {
+	dir := t.TempDir()
+	dc, err := NewDiskCache(dir, 1024*1024, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dc.Put("job1", "metrics", testJobData(100)); err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(dir, diskCacheFilename("job1", "metrics"))
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[len(b)-1] ^= 0xff
+	if err := os.WriteFile(filename, b, 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := dc.Get("job1", "metrics"); ok {
+		t.Fatal("expected corrupted entry to be discarded")
+	}
+	if _, err := os.Stat(filename); !os.IsNotExist(err) {
+		t.Error("expected corrupted entry to be removed")
+	}
+}
+
+func TestDiskCacheExpiration(t *testing.T) {
+	dc, err := NewDiskCache(t.TempDir(), 1024*1024, -time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dc.Put("job1", "metrics", testJobData(100)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := dc.Get("job1", "metrics"); ok {
+		t.Fatal("expected expired entry to be discarded")
+	}
+}
+
+func TestDiskCacheInvalidate(t *testing.T) {
+	dc, err := NewDiskCache(t.TempDir(), 1024*1024, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"metrics", "other metrics"} {
+		if err := dc.Put("job1", key, testJobData(100)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := dc.Put("job2", "metrics", testJobData(100)); err != nil {
+		t.Fatal(err)
+	}
+
+	dc.Invalidate("job1")
+	for _, key := range []string{"metrics", "other metrics"} {
+		if _, ok := dc.Get("job1", key); ok {
+			t.Errorf("expected entry %s of invalidated group to be removed", key)
+		}
+	}
+	if _, ok := dc.Get("job2", "metrics"); !ok {
+		t.Error("expected entry of other group to be cached")
+	}
+	if dc.lru.Len() != 1 {
+		t.Errorf("unexpected number of entries: %d", dc.lru.Len())
+	}
+}
+
+func TestDiskCacheRemovedFile(t *testing.T) {
+	dir := t.TempDir()
+	dc, err := NewDiskCache(dir, 1024*1024, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dc.Put("job1", "metrics", testJobData(100)); err != nil {
+		t.Fatal(err)
+	}
+
+	// As if evicted between the lookup and the read
+	if err := os.Remove(filepath.Join(dir, diskCacheFilename("job1", "metrics"))); err != nil {
+		t.Fatal(err)
+	}
+	corrupted := counterValue(t, diskCacheCorrupted)
+	if _, ok := dc.Get("job1", "metrics"); ok {
+		t.Fatal("expected removed entry to be a miss")
+	}
+	if counterValue(t, diskCacheCorrupted) != corrupted {
+		t.Error("expected removed entry not to be counted as corrupted")
+	}
+}
+
+func counterValue(t *testing.T, c prometheus.Counter) float64 {
+	var m dto.Metric
+	if err := c.Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetCounter().GetValue()
+}
@@ -0,0 +1,617 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/resampler"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+type GraphiteDataRepositoryConfig struct {
+	Url      string `json:"url"`
+	Username string `json:"username,omitempty"`
+	// Go text/template per metric and scope resolving to a graphite path,
+	// e.g. "collectd.{{.Hostname}}.cpu-{{.TypeId}}.percent-active"
+	Templates map[string]map[schema.MetricScope]string `json:"target-templates"`
+}
+
+type GraphiteDataRepository struct {
+	client    http.Client
+	renderUrl string
+	username  string
+	password  string
+	templates map[string]map[schema.MetricScope]*template.Template
+}
+
+// Arguments available in graphite target templates
+type GraphiteTargetArgs struct {
+	Cluster  string
+	Hostname string
+	// hwthread, core, memory domain, socket or accelerator ID, empty for node scope
+	TypeId string
+}
+
+type graphiteQuery struct {
+	metric   string
+	scope    schema.MetricScope
+	hostname string
+	typeId   *string
+	target   string
+}
+
+type graphiteSeries struct {
+	Target     string        `json:"target"`
+	Datapoints [][2]*float64 `json:"datapoints"`
+}
+
+// Maximum number of targets sent with one render request
+const graphiteMaxTargets = 256
+
+func (gdr *GraphiteDataRepository) Init(rawConfig json.RawMessage) error {
+	var config GraphiteDataRepositoryConfig
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		log.Warn("Error while unmarshaling raw json config")
+		return err
+	}
+
+	// support basic authentication
+	gdr.username = config.Username
+	if config.Username != "" {
+		gdr.password = os.Getenv("GRAPHITE_PASSWORD")
+		if gdr.password == "" {
+			return errors.New("METRICDATA/GRAPHITE > Graphite username provided, but GRAPHITE_PASSWORD not set")
+		}
+	}
+
+	gdr.renderUrl = fmt.Sprintf("%s/render", strings.TrimSuffix(config.Url, "/"))
+	gdr.client = http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	gdr.templates = make(map[string]map[schema.MetricScope]*template.Template)
+	for metric, scopes := range config.Templates {
+		gdr.templates[metric] = make(map[schema.MetricScope]*template.Template)
+		for scope, templ := range scopes {
+			t, err := template.New(metric + "/" + string(scope)).Parse(templ)
+			if err != nil {
+				log.Warnf("Failed to parse graphite template %s for metric %s", templ, metric)
+				return err
+			}
+			gdr.templates[metric][scope] = t
+			log.Debugf("Added graphite template for %s (%s): %s", metric, scope, templ)
+		}
+	}
+
+	return nil
+}
+
+func (gdr *GraphiteDataRepository) formatTarget(
+	metric string,
+	scope schema.MetricScope,
+	args GraphiteTargetArgs,
+) (string, error) {
+	templ, ok := gdr.templates[metric][scope]
+	if !ok {
+		return "", fmt.Errorf("METRICDATA/GRAPHITE > No target template for metric %s and scope %s configured", metric, scope)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := templ.Execute(buf, args); err != nil {
+		return "", fmt.Errorf("METRICDATA/GRAPHITE > Error compiling template for metric %s: %w", metric, err)
+	}
+
+	return buf.String(), nil
+}
+
+// Return the IDs of the hardware units of the given scope covered by the
+// hwthreads and accelerators of host.
+func graphiteTypeIds(
+	scope schema.MetricScope,
+	topology *schema.Topology,
+	host *schema.Resource,
+) []string {
+	hwthreads := host.HWThreads
+	if hwthreads == nil {
+		hwthreads = topology.Node
+	}
+
+	var ids []int
+	switch scope {
+	case schema.MetricScopeNode:
+		return []string{""}
+	case schema.MetricScopeAccelerator:
+		if host.Accelerators != nil {
+			return host.Accelerators
+		}
+		return topology.GetAcceleratorIDs()
+	case schema.MetricScopeHWThread:
+		ids = hwthreads
+	case schema.MetricScopeCore:
+		ids, _ = topology.GetCoresFromHWThreads(hwthreads)
+	case schema.MetricScopeMemoryDomain:
+		ids, _ = topology.GetMemoryDomainsFromHWThreads(hwthreads)
+	case schema.MetricScopeSocket:
+		ids, _ = topology.GetSocketsFromHWThreads(hwthreads)
+	}
+
+	sort.Ints(ids)
+	return intToStringSlice(ids)
+}
+
+func (gdr *GraphiteDataRepository) buildQueries(
+	cluster string,
+	topology *schema.Topology,
+	hosts []*schema.Resource,
+	metrics []string,
+	scopes []schema.MetricScope,
+) ([]graphiteQuery, error) {
+	queries := make([]graphiteQuery, 0, len(metrics)*len(scopes)*len(hosts))
+
+	for _, metric := range metrics {
+		templates, ok := gdr.templates[metric]
+		if !ok {
+			log.Infof("no graphite target template for metric '%s' of cluster '%s'", metric, cluster)
+			continue
+		}
+
+		// Use the node scope for scopes without a template
+		handledScopes := make([]schema.MetricScope, 0, len(scopes))
+		for _, requestedScope := range scopes {
+			scope := requestedScope
+			if _, ok := templates[scope]; !ok {
+				scope = schema.MetricScopeNode
+			}
+			if _, ok := templates[scope]; !ok || contains(handledScopes, scope) {
+				continue
+			}
+			if scope != schema.MetricScopeNode && topology == nil {
+				continue
+			}
+			handledScopes = append(handledScopes, scope)
+
+			for _, host := range hosts {
+				ids := []string{""}
+				if scope != schema.MetricScopeNode {
+					ids = graphiteTypeIds(scope, topology, host)
+				}
+
+				for _, id := range ids {
+					target, err := gdr.formatTarget(metric, scope, GraphiteTargetArgs{
+						Cluster:  cluster,
+						Hostname: host.Hostname,
+						TypeId:   id,
+					})
+					if err != nil {
+						return nil, err
+					}
+
+					q := graphiteQuery{
+						metric:   metric,
+						scope:    scope,
+						hostname: host.Hostname,
+						target:   target,
+					}
+					if scope != schema.MetricScopeNode {
+						q.typeId = new(string)
+						*q.typeId = id
+					}
+					queries = append(queries, q)
+				}
+			}
+		}
+	}
+
+	return queries, nil
+}
+
+// Query the graphite render API. The returned slice contains the series
+// for each query in the same order, nil if graphite returned no data.
+func (gdr *GraphiteDataRepository) doRequest(
+	ctx context.Context,
+	queries []graphiteQuery,
+	from, to time.Time,
+) ([]*graphiteSeries, error) {
+	results := make([]*graphiteSeries, len(queries))
+
+	for start := 0; start < len(queries); start += graphiteMaxTargets {
+		end := min(start+graphiteMaxTargets, len(queries))
+
+		form := url.Values{}
+		form.Set("from", strconv.FormatInt(from.Unix(), 10))
+		form.Set("until", strconv.FormatInt(to.Unix(), 10))
+		form.Set("format", "json")
+		for i := start; i < end; i++ {
+			// The alias maps the returned series back to the query
+			form.Add("target", fmt.Sprintf("alias(%s,\"%d\")", queries[i].target, i))
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, gdr.renderUrl, strings.NewReader(form.Encode()))
+		if err != nil {
+			log.Warn("Error while building request body")
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if gdr.username != "" {
+			req.SetBasicAuth(gdr.username, gdr.password)
+		}
+
+		res, err := gdr.client.Do(req)
+		if err != nil {
+			log.Error("Error while performing request")
+			return nil, err
+		}
+
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return nil, fmt.Errorf("METRICDATA/GRAPHITE > '%s': HTTP Status: %s", gdr.renderUrl, res.Status)
+		}
+
+		var resBody []*graphiteSeries
+		err = json.NewDecoder(res.Body).Decode(&resBody)
+		res.Body.Close()
+		if err != nil {
+			log.Warn("Error while decoding result body")
+			return nil, err
+		}
+
+		for _, series := range resBody {
+			i, err := strconv.Atoi(series.Target)
+			if err != nil || i < start || i >= end {
+				log.Warnf("Unexpected graphite series '%s'", series.Target)
+				continue
+			}
+			results[i] = series
+		}
+	}
+
+	return results, nil
+}
+
+// Convert a graphite series to a schema.Series. Returns the series and its
+// timestep, which is the storage resolution reported by graphite or
+// defaultStep if it cannot be determined.
+func graphiteToSeries(
+	gs *graphiteSeries,
+	hostname string,
+	id *string,
+	defaultStep int,
+	resolution int,
+) (schema.Series, int) {
+	step := defaultStep
+	if len(gs.Datapoints) > 1 && gs.Datapoints[0][1] != nil && gs.Datapoints[1][1] != nil {
+		step = int(*gs.Datapoints[1][1] - *gs.Datapoints[0][1])
+	}
+
+	data := make([]schema.Float, len(gs.Datapoints))
+	for i, dp := range gs.Datapoints {
+		if dp[0] == nil {
+			data[i] = schema.NaN
+		} else {
+			data[i] = schema.Float(*dp[0])
+		}
+	}
+
+	stats := schema.MetricStatistics{}
+	if min, max, avg := MinMaxMean(data); !math.IsNaN(avg) {
+		stats = schema.MetricStatistics{Avg: avg, Min: min, Max: max}
+	}
+
+	if resolution > step {
+		if resampled, newStep, err := resampler.LargestTriangleThreeBucket(data, step, resolution); err == nil {
+			data, step = resampled, newStep
+		}
+	}
+
+	return schema.Series{
+		Hostname:   hostname,
+		Id:         id,
+		Data:       data,
+		Statistics: stats,
+	}, step
+}
+
+func (gdr *GraphiteDataRepository) LoadData(
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	ctx context.Context,
+	resolution int,
+) (schema.JobData, error) {
+	if len(scopes) == 0 {
+		scopes = []schema.MetricScope{schema.MetricScopeNode}
+	}
+
+	subcluster, err := archive.GetSubCluster(job.Cluster, job.SubCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := gdr.buildQueries(job.Cluster, &subcluster.Topology, job.Resources, metrics, scopes)
+	if err != nil {
+		log.Warn("Error while building queries")
+		return nil, err
+	}
+
+	from := job.StartTime
+	to := job.StartTime.Add(time.Duration(job.Duration) * time.Second)
+	results, err := gdr.doRequest(ctx, queries, from, to)
+	if err != nil {
+		log.Error("Error while performing request")
+		return nil, err
+	}
+
+	var errors []string
+	jobData := make(schema.JobData)
+	for i, gs := range results {
+		query := queries[i]
+		if gs == nil {
+			errors = append(errors, fmt.Sprintf("no data for '%s' from host '%s'", query.metric, query.hostname))
+			continue
+		}
+
+		mc := archive.GetMetricConfig(job.Cluster, query.metric)
+		if mc == nil {
+			continue
+		}
+		series, step := graphiteToSeries(gs, query.hostname, query.typeId, mc.Timestep, resolution)
+
+		if _, ok := jobData[query.metric]; !ok {
+			jobData[query.metric] = make(map[schema.MetricScope]*schema.JobMetric)
+		}
+		jobMetric, ok := jobData[query.metric][query.scope]
+		if !ok {
+			jobMetric = &schema.JobMetric{
+				Unit:     mc.Unit,
+				Timestep: step,
+				Series:   make([]schema.Series, 0),
+			}
+			jobData[query.metric][query.scope] = jobMetric
+		}
+		jobMetric.Series = append(jobMetric.Series, series)
+	}
+
+	if len(errors) != 0 {
+		/* Returns list for "partial errors" */
+		return jobData, fmt.Errorf("METRICDATA/GRAPHITE > Errors: %s", strings.Join(errors, ", "))
+	}
+	return jobData, nil
+}
+
+func (gdr *GraphiteDataRepository) LoadStats(
+	job *schema.Job,
+	metrics []string,
+	ctx context.Context,
+) (map[string]map[string]schema.MetricStatistics, error) {
+	data, err := gdr.LoadData(job, metrics, []schema.MetricScope{schema.MetricScopeNode}, ctx, 0)
+	if err != nil && len(data) == 0 {
+		log.Warn("Error while loading job for stats")
+		return nil, err
+	}
+
+	stats := make(map[string]map[string]schema.MetricStatistics, len(data))
+	for metric, metricData := range data {
+		jm, ok := metricData[schema.MetricScopeNode]
+		if !ok {
+			continue
+		}
+
+		stats[metric] = make(map[string]schema.MetricStatistics, len(jm.Series))
+		for _, series := range jm.Series {
+			stats[metric][series.Hostname] = series.Statistics
+		}
+	}
+
+	return stats, nil
+}
+
+// Return all nodes of cluster (and subcluster if not empty) in sorted order.
+func graphiteClusterNodes(cluster, subCluster string) []string {
+	var nodes []string
+	for sc, nl := range archive.NodeLists[cluster] {
+		if subCluster == "" || sc == subCluster {
+			nodes = append(nodes, nl.PrintList()...)
+		}
+	}
+
+	sort.Strings(nodes)
+	return nodes
+}
+
+func (gdr *GraphiteDataRepository) LoadNodeData(
+	cluster string,
+	metrics, nodes []string,
+	scopes []schema.MetricScope,
+	from, to time.Time,
+	ctx context.Context,
+) (map[string]map[string][]*schema.JobMetric, error) {
+	if nodes == nil {
+		nodes = graphiteClusterNodes(cluster, "")
+	}
+
+	hosts := make([]*schema.Resource, 0, len(nodes))
+	for _, node := range nodes {
+		hosts = append(hosts, &schema.Resource{Hostname: node})
+	}
+
+	// Only node scope is supported here, the subcluster of a node is unknown
+	queries, err := gdr.buildQueries(cluster, nil, hosts, metrics, []schema.MetricScope{schema.MetricScopeNode})
+	if err != nil {
+		log.Warn("Error while building queries")
+		return nil, err
+	}
+
+	results, err := gdr.doRequest(ctx, queries, from, to)
+	if err != nil {
+		log.Error("Error while performing request")
+		return nil, err
+	}
+
+	var errors []string
+	data := make(map[string]map[string][]*schema.JobMetric)
+	for i, gs := range results {
+		query := queries[i]
+		if gs == nil {
+			errors = append(errors, fmt.Sprintf("fetching %s for node %s failed: no data", query.metric, query.hostname))
+			continue
+		}
+
+		mc := archive.GetMetricConfig(cluster, query.metric)
+		if mc == nil {
+			continue
+		}
+		series, step := graphiteToSeries(gs, query.hostname, nil, mc.Timestep, 0)
+
+		hostdata, ok := data[query.hostname]
+		if !ok {
+			hostdata = make(map[string][]*schema.JobMetric)
+			data[query.hostname] = hostdata
+		}
+		hostdata[query.metric] = append(hostdata[query.metric], &schema.JobMetric{
+			Unit:     mc.Unit,
+			Timestep: step,
+			Series:   []schema.Series{series},
+		})
+	}
+
+	if len(errors) != 0 {
+		/* Returns list of "partial errors" */
+		return data, fmt.Errorf("METRICDATA/GRAPHITE > Errors: %s", strings.Join(errors, ", "))
+	}
+
+	return data, nil
+}
+
+func (gdr *GraphiteDataRepository) LoadNodeListData(
+	cluster, subCluster, nodeFilter string,
+	metrics []string,
+	scopes []schema.MetricScope,
+	resolution int,
+	from, to time.Time,
+	page *model.PageRequest,
+	ctx context.Context,
+) (map[string]schema.JobData, int, bool, error) {
+	var hasNextPage bool = false
+
+	nodes := graphiteClusterNodes(cluster, subCluster)
+	if nodeFilter != "" {
+		filteredNodes := []string{}
+		for _, node := range nodes {
+			if strings.Contains(node, nodeFilter) {
+				filteredNodes = append(filteredNodes, node)
+			}
+		}
+		nodes = filteredNodes
+	}
+	totalNodes := len(nodes)
+
+	if page != nil && len(nodes) > page.ItemsPerPage {
+		start := (page.Page - 1) * page.ItemsPerPage
+		end := start + page.ItemsPerPage
+		if start > len(nodes) {
+			start = len(nodes)
+		}
+		if end > len(nodes) {
+			end = len(nodes)
+		} else {
+			hasNextPage = true
+		}
+		nodes = nodes[start:end]
+	}
+
+	if len(scopes) == 0 {
+		scopes = []schema.MetricScope{schema.MetricScopeNode}
+	}
+
+	var errors []string
+	queries := make([]graphiteQuery, 0)
+	for _, node := range nodes {
+		sc := subCluster
+		if sc == "" {
+			var err error
+			if sc, err = archive.GetSubClusterByNode(cluster, node); err != nil {
+				errors = append(errors, err.Error())
+				continue
+			}
+		}
+
+		subcluster, err := archive.GetSubCluster(cluster, sc)
+		if err != nil {
+			errors = append(errors, err.Error())
+			continue
+		}
+
+		nodeQueries, err := gdr.buildQueries(cluster, &subcluster.Topology, []*schema.Resource{{Hostname: node}}, metrics, scopes)
+		if err != nil {
+			log.Warn("Error while building queries")
+			return nil, totalNodes, hasNextPage, err
+		}
+		queries = append(queries, nodeQueries...)
+	}
+
+	results, err := gdr.doRequest(ctx, queries, from, to)
+	if err != nil {
+		log.Error("Error while performing request")
+		return nil, totalNodes, hasNextPage, err
+	}
+
+	data := make(map[string]schema.JobData)
+	for i, gs := range results {
+		query := queries[i]
+		if gs == nil {
+			errors = append(errors, fmt.Sprintf("failed to fetch '%s' from host '%s': no data", query.metric, query.hostname))
+			continue
+		}
+
+		mc := archive.GetMetricConfig(cluster, query.metric)
+		if mc == nil {
+			continue
+		}
+		series, step := graphiteToSeries(gs, query.hostname, query.typeId, mc.Timestep, resolution)
+
+		hostData, ok := data[query.hostname]
+		if !ok {
+			hostData = make(schema.JobData)
+			data[query.hostname] = hostData
+		}
+		if _, ok := hostData[query.metric]; !ok {
+			hostData[query.metric] = make(map[schema.MetricScope]*schema.JobMetric)
+		}
+		scopeData, ok := hostData[query.metric][query.scope]
+		if !ok {
+			scopeData = &schema.JobMetric{
+				Unit:     mc.Unit,
+				Timestep: step,
+				Series:   make([]schema.Series, 0),
+			}
+			hostData[query.metric][query.scope] = scopeData
+		}
+		scopeData.Series = append(scopeData.Series, series)
+	}
+
+	if len(errors) != 0 {
+		/* Returns list of "partial errors" */
+		return data, totalNodes, hasNextPage, fmt.Errorf("METRICDATA/GRAPHITE > Errors: %s", strings.Join(errors, ", "))
+	}
+
+	return data, totalNodes, hasNextPage, nil
+}
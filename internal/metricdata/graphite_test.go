@@ -0,0 +1,248 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+const graphiteTestConfig = `{
+	"url": "%s",
+	"target-templates": {
+		"cpu_load":  { "node": "{{.Cluster}}.{{.Hostname}}.cpu_load" },
+		"flops_any": {
+			"node":   "{{.Cluster}}.{{.Hostname}}.flops_any",
+			"socket": "{{.Cluster}}.{{.Hostname}}.socket{{.TypeId}}.flops_any"
+		}
+	}
+}`
+
+func setupGraphite(t *testing.T, url string) *GraphiteDataRepository {
+	t.Helper()
+	if err := archive.Init(json.RawMessage(`{"kind": "file", "path": "../../pkg/archive/testdata/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	gdr := &GraphiteDataRepository{}
+	if err := gdr.Init(json.RawMessage(fmt.Sprintf(graphiteTestConfig, url))); err != nil {
+		t.Fatal(err)
+	}
+	return gdr
+}
+
+func graphitePoint(v float64) *float64 {
+	return &v
+}
+
+func TestGraphiteTargetTemplates(t *testing.T) {
+	gdr := setupGraphite(t, "http://localhost")
+	subcluster, err := archive.GetSubCluster("fritz", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hosts := []*schema.Resource{{Hostname: "f0101", HWThreads: []int{0, 1, 2, 3}}}
+
+	// Scopes without a template fall back to the node scope, metrics without
+	// templates are skipped
+	queries, err := gdr.buildQueries("fritz", &subcluster.Topology, hosts,
+		[]string{"cpu_load", "flops_any", "mem_used"},
+		[]schema.MetricScope{schema.MetricScopeSocket, schema.MetricScopeCore})
+	if err != nil {
+		t.Fatal(err)
+	}
+	socket := "0"
+	want := []graphiteQuery{
+		{metric: "cpu_load", scope: schema.MetricScopeNode, hostname: "f0101", target: "fritz.f0101.cpu_load"},
+		{metric: "flops_any", scope: schema.MetricScopeSocket, hostname: "f0101", typeId: &socket, target: "fritz.f0101.socket0.flops_any"},
+		{metric: "flops_any", scope: schema.MetricScopeNode, hostname: "f0101", target: "fritz.f0101.flops_any"},
+	}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("unexpected queries\ngot: %#v\nwant: %#v", queries, want)
+	}
+
+	// Without a topology only the node scope can be queried
+	queries, err = gdr.buildQueries("fritz", nil, hosts, []string{"flops_any"}, []schema.MetricScope{schema.MetricScopeSocket})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queries) != 0 {
+		t.Errorf("expected no queries without topology, got %#v", queries)
+	}
+
+	if err := gdr.Init(json.RawMessage(`{"target-templates": {"cpu_load": {"node": "{{.Unknown}}"}}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gdr.formatTarget("cpu_load", schema.MetricScopeNode, GraphiteTargetArgs{}); err == nil {
+		t.Error("expected error for template with unknown field")
+	}
+}
+
+func TestGraphiteLoadData(t *testing.T) {
+	t.Setenv("GRAPHITE_PASSWORD", "secret")
+	var form map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "cc" || password != "secret" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		form = r.PostForm
+
+		// The series come back in any order, the flops_any node series is
+		// missing and series not asked for are ignored
+		json.NewEncoder(rw).Encode([]*graphiteSeries{
+			{Target: "unexpected", Datapoints: [][2]*float64{{graphitePoint(7), graphitePoint(1700000000)}}},
+			{Target: "2", Datapoints: [][2]*float64{
+				{graphitePoint(10), graphitePoint(1700000000)},
+				{graphitePoint(20), graphitePoint(1700000030)},
+			}},
+			{Target: "0", Datapoints: [][2]*float64{
+				{graphitePoint(1), graphitePoint(1700000000)},
+				{nil, graphitePoint(1700000030)},
+				{graphitePoint(3), graphitePoint(1700000060)},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	gdr := setupGraphite(t, server.URL)
+	gdr.username, gdr.password = "cc", "secret"
+
+	job := &schema.Job{
+		StartTime: time.Unix(1700000000, 0),
+		BaseJob: schema.BaseJob{
+			Cluster:    "fritz",
+			SubCluster: "main",
+			Duration:   60,
+			Resources:  []*schema.Resource{{Hostname: "f0101", HWThreads: []int{0, 1, 2, 3}}},
+		},
+	}
+	jobData, err := gdr.LoadData(job, []string{"cpu_load", "flops_any"},
+		[]schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeSocket}, context.Background(), 0)
+	if err == nil || !strings.Contains(err.Error(), "no data for 'flops_any' from host 'f0101'") {
+		t.Errorf("expected partial error for missing series: %v", err)
+	}
+
+	if form["from"][0] != "1700000000" || form["until"][0] != "1700000060" || form["format"][0] != "json" {
+		t.Errorf("unexpected request: %#v", form)
+	}
+	wantTargets := []string{
+		`alias(fritz.f0101.cpu_load,"0")`,
+		`alias(fritz.f0101.flops_any,"1")`,
+		`alias(fritz.f0101.socket0.flops_any,"2")`,
+	}
+	if !reflect.DeepEqual(form["target"], wantTargets) {
+		t.Errorf("unexpected targets\ngot: %#v\nwant: %#v", form["target"], wantTargets)
+	}
+
+	// Null datapoints become NaN and are left out of the statistics
+	cpuLoad := jobData["cpu_load"][schema.MetricScopeNode]
+	if cpuLoad == nil || len(cpuLoad.Series) != 1 {
+		t.Fatalf("unexpected cpu_load data: %#v", jobData["cpu_load"])
+	}
+	series := cpuLoad.Series[0]
+	if len(series.Data) != 3 || series.Data[0] != 1 || !math.IsNaN(float64(series.Data[1])) || series.Data[2] != 3 {
+		t.Errorf("unexpected cpu_load series: %v", series.Data)
+	}
+	if series.Statistics != (schema.MetricStatistics{Avg: 2, Min: 1, Max: 3}) {
+		t.Errorf("unexpected cpu_load statistics: %#v", series.Statistics)
+	}
+	// The timestep is the storage resolution of graphite, not the configured one
+	if cpuLoad.Timestep != 30 || series.Hostname != "f0101" || series.Id != nil {
+		t.Errorf("unexpected cpu_load metric: %#v", cpuLoad)
+	}
+
+	flops := jobData["flops_any"][schema.MetricScopeSocket]
+	if flops == nil || len(flops.Series) != 1 || flops.Series[0].Id == nil || *flops.Series[0].Id != "0" {
+		t.Fatalf("unexpected flops_any data: %#v", jobData["flops_any"])
+	}
+	if _, ok := jobData["flops_any"][schema.MetricScopeNode]; ok {
+		t.Error("expected no node data for the missing flops_any series")
+	}
+}
+
+func TestGraphiteSeriesGaps(t *testing.T) {
+	// Without two timestamps the configured timestep is used
+	series, step := graphiteToSeries(&graphiteSeries{Datapoints: [][2]*float64{{nil, graphitePoint(1700000000)}}},
+		"f0101", nil, 60, 0)
+	if step != 60 || len(series.Data) != 1 || !math.IsNaN(float64(series.Data[0])) {
+		t.Errorf("unexpected series: %v %d", series.Data, step)
+	}
+	if series.Statistics != (schema.MetricStatistics{}) {
+		t.Errorf("expected empty statistics for series without values: %#v", series.Statistics)
+	}
+
+	series, step = graphiteToSeries(&graphiteSeries{}, "f0101", nil, 60, 0)
+	if step != 60 || len(series.Data) != 0 {
+		t.Errorf("unexpected empty series: %v %d", series.Data, step)
+	}
+}
+
+func TestGraphiteBatches(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		res := make([]*graphiteSeries, 0, len(r.PostForm["target"]))
+		for _, target := range r.PostForm["target"] {
+			// alias(f<i>,"<i>")
+			alias := strings.TrimSuffix(target[strings.Index(target, ",\"")+2:], "\")")
+			var v float64
+			fmt.Sscan(alias, &v)
+			res = append(res, &graphiteSeries{Target: alias, Datapoints: [][2]*float64{{graphitePoint(v), graphitePoint(1700000000)}}})
+		}
+		json.NewEncoder(rw).Encode(res)
+	}))
+	defer server.Close()
+
+	gdr := setupGraphite(t, server.URL)
+	queries := make([]graphiteQuery, graphiteMaxTargets+44)
+	for i := range queries {
+		queries[i] = graphiteQuery{metric: "cpu_load", hostname: fmt.Sprintf("f%d", i), target: fmt.Sprintf("f%d", i)}
+	}
+
+	results, err := gdr.doRequest(context.Background(), queries, time.Unix(1700000000, 0), time.Unix(1700000060, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests for %d targets, got %d", len(queries), requests)
+	}
+	for i, series := range results {
+		if series == nil || *series.Datapoints[0][0] != float64(i) {
+			t.Fatalf("unexpected series for query %d: %#v", i, series)
+		}
+	}
+
+	server.Config.Handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+	})
+	if _, err := gdr.doRequest(context.Background(), queries[:1], time.Unix(1700000000, 0), time.Unix(1700000060, 0)); err == nil {
+		t.Error("expected error for failed request")
+	}
+}
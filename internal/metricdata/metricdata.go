@@ -55,6 +55,8 @@ func Init() error {
 				mdr = &InfluxDBv2DataRepository{}
 			case "prometheus":
 				mdr = &PrometheusDataRepository{}
+			case "graphite":
+				mdr = &GraphiteDataRepository{}
 			case "test":
 				mdr = &TestMetricDataRepository{}
 			default:
@@ -0,0 +1,411 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// Tag added to jobs started after the budget of their allocation was used up
+const (
+	OverBudgetTagType = "allocation"
+	OverBudgetTagName = "over-budget"
+)
+
+var (
+	allocationRepoOnce     sync.Once
+	allocationRepoInstance *AllocationRepository
+)
+
+// AllocationRepository stores the allocation periods and budgets of projects.
+// The consumption is computed from the jobs of the project started within
+// the allocation period.
+type AllocationRepository struct {
+	DB        *sqlx.DB
+	stmtCache *sq.StmtCache
+	driver    string
+}
+
+func GetAllocationRepository() *AllocationRepository {
+	allocationRepoOnce.Do(func() {
+		db := GetConnection()
+
+		allocationRepoInstance = &AllocationRepository{
+			DB:     db.DB,
+			driver: db.Driver,
+
+			stmtCache: sq.NewStmtCache(db.DB),
+		}
+	})
+	return allocationRepoInstance
+}
+
+var allocationColumns []string = []string{
+	"allocation.id", "allocation.project", "allocation.cluster", "allocation.start_time",
+	"allocation.end_time", "allocation.core_hours", "allocation.acc_hours", "allocation.energy",
+}
+
+func scanAllocation(row interface{ Scan(...interface{}) error }) (*schema.Allocation, error) {
+	a := &schema.Allocation{}
+	var startTime, endTime int64
+
+	if err := row.Scan(
+		&a.ID, &a.Project, &a.Cluster, &startTime,
+		&endTime, &a.CoreHours, &a.AccHours, &a.Energy); err != nil {
+		log.Warnf("Error while scanning rows (Allocation): %v", err)
+		return nil, err
+	}
+	a.StartTime = time.Unix(startTime, 0)
+	a.EndTime = time.Unix(endTime, 0)
+
+	return a, nil
+}
+
+func checkAllocation(a *schema.Allocation) error {
+	if a.Project == "" || a.Cluster == "" {
+		return errors.New("an allocation needs a project and a cluster")
+	}
+	if !a.StartTime.Before(a.EndTime) {
+		return errors.New("the allocation period has to start before it ends")
+	}
+	for _, budget := range []*float64{a.CoreHours, a.AccHours, a.Energy} {
+		if budget != nil && *budget < 0 {
+			return errors.New("budgets must not be negative")
+		}
+	}
+	return nil
+}
+
+// Periods of allocations of the same project on the same cluster must not
+// overlap, otherwise jobs would count against several budgets.
+func checkAllocationOverlap(tx *sqlx.Tx, a *schema.Allocation) error {
+	query, args, err := sq.Select("COUNT(*)").From("allocation").
+		Where("allocation.project = ?", a.Project).
+		Where("allocation.cluster = ?", a.Cluster).
+		Where("allocation.start_time < ?", a.EndTime.Unix()).
+		Where("allocation.end_time > ?", a.StartTime.Unix()).
+		Where("allocation.id != ?", a.ID).ToSql()
+	if err != nil {
+		return err
+	}
+
+	var count int
+	if err := tx.QueryRow(query, args...).Scan(&count); err != nil {
+		log.Errorf("Error while checking allocation periods: %v", err)
+		return err
+	}
+	if count != 0 {
+		return fmt.Errorf("the allocation period overlaps another allocation of project %s on %s", a.Project, a.Cluster)
+	}
+	return nil
+}
+
+// Add stores a new allocation.
+func (r *AllocationRepository) Add(a *schema.Allocation) (*schema.Allocation, error) {
+	if err := checkAllocation(a); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting allocation transaction")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := checkAllocationOverlap(tx, a); err != nil {
+		return nil, err
+	}
+
+	q := sq.Insert("allocation").
+		Columns("project", "cluster", "start_time", "end_time", "core_hours", "acc_hours", "energy").
+		Values(a.Project, a.Cluster, a.StartTime.Unix(), a.EndTime.Unix(), a.CoreHours, a.AccHours, a.Energy)
+
+	if a.ID, err = insertReturningId(tx, q); err != nil {
+		log.Errorf("Error while inserting allocation: %v", err)
+		return nil, err
+	}
+	return a, tx.Commit()
+}
+
+// Update replaces the period and budgets of the allocation with the id of `a`.
+func (r *AllocationRepository) Update(a *schema.Allocation) (*schema.Allocation, error) {
+	if err := checkAllocation(a); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting allocation transaction")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := checkAllocationOverlap(tx, a); err != nil {
+		return nil, err
+	}
+
+	query, args, err := sq.Update("allocation").
+		Set("project", a.Project).
+		Set("cluster", a.Cluster).
+		Set("start_time", a.StartTime.Unix()).
+		Set("end_time", a.EndTime.Unix()).
+		Set("core_hours", a.CoreHours).
+		Set("acc_hours", a.AccHours).
+		Set("energy", a.Energy).
+		Where("allocation.id = ?", a.ID).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	res, err := tx.Exec(query, args...)
+	if err != nil {
+		log.Errorf("Error while updating allocation: %v", err)
+		return nil, err
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return nil, fmt.Errorf("no allocation with id %d", a.ID)
+	}
+
+	return a, tx.Commit()
+}
+
+// Delete removes the allocation with the database id `id`.
+func (r *AllocationRepository) Delete(id int64) error {
+	if _, err := sq.Delete("allocation").Where("allocation.id = ?", id).
+		RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while deleting allocation: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// Get returns the allocation with the database id `id`.
+func (r *AllocationRepository) Get(id int64) (*schema.Allocation, error) {
+	return scanAllocation(sq.Select(allocationColumns...).From("allocation").
+		Where("allocation.id = ?", id).RunWith(r.stmtCache).QueryRow())
+}
+
+// List returns the allocations visible to the user, optionally restricted to
+// a project, a cluster and to allocations active at the time `activeAt`.
+// Admins and support staff can see all allocations, managers the
+// allocations of their projects.
+func (r *AllocationRepository) List(user *schema.User, project *string, cluster *string, activeAt *time.Time) ([]*schema.Allocation, error) {
+	q := sq.Select(allocationColumns...).From("allocation").
+		OrderBy("allocation.project ASC", "allocation.cluster ASC", "allocation.start_time ASC")
+
+	if user != nil && !user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
+		if !user.HasRole(schema.RoleManager) || len(user.Projects) == 0 {
+			return nil, errors.New("you need to be administrator, support staff or project manager for this query")
+		}
+		q = q.Where(sq.Eq{"allocation.project": user.Projects})
+	}
+	if project != nil {
+		q = q.Where("allocation.project = ?", *project)
+	}
+	if cluster != nil {
+		q = q.Where("allocation.cluster = ?", *cluster)
+	}
+	if activeAt != nil {
+		q = q.Where("allocation.start_time <= ?", activeAt.Unix()).Where("allocation.end_time > ?", activeAt.Unix())
+	}
+
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		queryString, queryVars, _ := q.ToSql()
+		log.Errorf("Error while running query '%s' %v: %v", queryString, queryVars, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	allocations := make([]*schema.Allocation, 0)
+	for rows.Next() {
+		a, err := scanAllocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, a)
+	}
+
+	return allocations, rows.Err()
+}
+
+// Jobs of the project started within the allocation period
+func allocationJobs(a *schema.Allocation, q sq.SelectBuilder) sq.SelectBuilder {
+	return q.From("job").
+		Where("job.project = ?", a.Project).
+		Where("job.cluster = ?", a.Cluster).
+		Where("job.deleted_at IS NULL").
+		Where("job.start_time >= ?", a.StartTime.Unix()).
+		Where("job.start_time < ?", a.EndTime.Unix())
+}
+
+// Core, accelerator seconds and energy of a job, running jobs up to now
+func consumptionColumns(now int64) []string {
+	elapsed := fmt.Sprintf("(CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END)", now)
+	return []string{
+		fmt.Sprintf("%s * job.num_hwthreads", elapsed),
+		fmt.Sprintf("%s * job.num_acc", elapsed),
+		"job.energy",
+	}
+}
+
+// Usage computes the consumption of the allocation from the jobs of the
+// project and the remaining budget and burn rate.
+func (r *AllocationRepository) Usage(a *schema.Allocation) (*schema.AllocationUsage, error) {
+	now := time.Now()
+	cols := consumptionColumns(now.Unix())
+	q := allocationJobs(a, sq.Select(
+		"COUNT(job.id)",
+		fmt.Sprintf("COALESCE(SUM(%s), 0)", cols[0]),
+		fmt.Sprintf("COALESCE(SUM(%s), 0)", cols[1]),
+		fmt.Sprintf("COALESCE(SUM(%s), 0)", cols[2])))
+
+	usage := &schema.AllocationUsage{}
+	var coreSeconds, accSeconds float64
+	if err := q.RunWith(r.stmtCache).QueryRow().Scan(&usage.NumJobs, &coreSeconds, &accSeconds, &usage.Energy); err != nil {
+		log.Warnf("Error while computing allocation usage: %v", err)
+		return nil, err
+	}
+	usage.CoreHours = coreSeconds / 3600
+	usage.AccHours = accSeconds / 3600
+
+	remaining := func(budget *float64, used float64) *float64 {
+		if budget == nil {
+			return nil
+		}
+		rest := *budget - used
+		return &rest
+	}
+	usage.RemainingCoreHours = remaining(a.CoreHours, usage.CoreHours)
+	usage.RemainingAccHours = remaining(a.AccHours, usage.AccHours)
+	usage.RemainingEnergy = remaining(a.Energy, usage.Energy)
+
+	end := a.EndTime
+	if now.Before(end) {
+		end = now
+	}
+	if days := end.Sub(a.StartTime).Hours() / 24; days > 0 {
+		usage.CoreHoursPerDay = usage.CoreHours / days
+		usage.AccHoursPerDay = usage.AccHours / days
+		usage.EnergyPerDay = usage.Energy / days
+	}
+
+	return usage, nil
+}
+
+// OverBudgetJobs returns the database ids of the jobs of the allocation
+// started when the budget was used up, including the job exceeding it.
+func (r *AllocationRepository) OverBudgetJobs(a *schema.Allocation) ([]int64, error) {
+	q := allocationJobs(a, sq.Select(append([]string{"job.id"}, consumptionColumns(time.Now().Unix())...)...)).
+		OrderBy("job.start_time ASC", "job.id ASC")
+
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while querying jobs of allocation: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+	var coreSeconds, accSeconds, energy float64
+	for rows.Next() {
+		var id int64
+		var cs, as, e float64
+		if err := rows.Scan(&id, &cs, &as, &e); err != nil {
+			log.Warnf("Error while scanning rows: %v", err)
+			return nil, err
+		}
+		coreSeconds, accSeconds, energy = coreSeconds+cs, accSeconds+as, energy+e
+		if a.Over(coreSeconds/3600, accSeconds/3600, energy) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, rows.Err()
+}
+
+// TagOverBudgetJobs adds the over-budget tag to all jobs of allocations
+// active within the last `since` that exceeded a budget and are not tagged
+// yet. It returns the number of tagged jobs and calls `onTagged`, if set,
+// for each of them.
+func (r *AllocationRepository) TagOverBudgetJobs(since time.Duration, onTagged func(job *schema.Job, tag *schema.Tag)) (int, error) {
+	q := sq.Select(allocationColumns...).From("allocation").
+		Where("allocation.end_time > ?", time.Now().Add(-since).Unix())
+
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while querying allocations: %v", err)
+		return 0, err
+	}
+	allocations := make([]*schema.Allocation, 0)
+	for rows.Next() {
+		a, err := scanAllocation(rows)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		allocations = append(allocations, a)
+	}
+	rows.Close()
+
+	jobRepo := GetJobRepository()
+	tagged := 0
+	for _, a := range allocations {
+		ids, err := r.OverBudgetJobs(a)
+		if err != nil {
+			return tagged, err
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		// Skip jobs already tagged
+		done := make(map[int64]bool)
+		if tagId, exists := jobRepo.TagId(OverBudgetTagType, OverBudgetTagName, "global"); exists {
+			rows, err := sq.Select("jobtag.job_id").From("jobtag").
+				Where("jobtag.tag_id = ?", tagId).Where(sq.Eq{"jobtag.job_id": ids}).
+				RunWith(r.stmtCache).Query()
+			if err != nil {
+				log.Errorf("Error while querying tagged jobs: %v", err)
+				return tagged, err
+			}
+			for rows.Next() {
+				var id int64
+				if err := rows.Scan(&id); err != nil {
+					rows.Close()
+					return tagged, err
+				}
+				done[id] = true
+			}
+			rows.Close()
+		}
+
+		for _, id := range ids {
+			if done[id] {
+				continue
+			}
+			job, tag, err := jobRepo.AddTagOrCreateDirect(id, OverBudgetTagType, OverBudgetTagName)
+			if err != nil {
+				log.Warnf("Error while tagging job (dbid: %d) over budget: %v", id, err)
+				continue
+			}
+			tagged++
+			if onTagged != nil {
+				onTagged(job, tag)
+			}
+		}
+	}
+
+	return tagged, nil
+}
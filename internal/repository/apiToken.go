@@ -0,0 +1,207 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	apiTokenRepoOnce     sync.Once
+	apiTokenRepoInstance *ApiTokenRepository
+)
+
+// ApiTokenRepository stores the API tokens of users. Only the SHA-256 of a
+// token is stored, the token itself is returned once when it is created.
+// Tokens are random with 256 bits, so that a slow password hash is not
+// needed.
+type ApiTokenRepository struct {
+	DB        *sqlx.DB
+	stmtCache *sq.StmtCache
+	driver    string
+}
+
+func GetApiTokenRepository() *ApiTokenRepository {
+	apiTokenRepoOnce.Do(func() {
+		db := GetConnection()
+
+		apiTokenRepoInstance = &ApiTokenRepository{
+			DB:     db.DB,
+			driver: db.Driver,
+
+			stmtCache: sq.NewStmtCache(db.DB),
+		}
+	})
+	return apiTokenRepoInstance
+}
+
+const ContextApiTokenKey ContextKey = "apiToken"
+
+// GetApiTokenFromContext returns the API token a request was authenticated
+// with, nil for all other kinds of authentication.
+func GetApiTokenFromContext(ctx context.Context) *schema.ApiToken {
+	t, _ := ctx.Value(ContextApiTokenKey).(*schema.ApiToken)
+	return t
+}
+
+var apiTokenColumns []string = []string{
+	"api_token.id", "api_token.name", "api_token.username", "api_token.scopes", "api_token.clusters",
+	"api_token.created_at", "api_token.expires_at", "api_token.last_used",
+}
+
+func splitList(raw string) []string {
+	list := make([]string, 0)
+	for _, s := range strings.Split(raw, ",") {
+		if s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func scanApiToken(row interface{ Scan(...interface{}) error }) (*schema.ApiToken, error) {
+	t := &schema.ApiToken{}
+	var rawScopes, rawClusters string
+	if err := row.Scan(&t.ID, &t.Name, &t.Username, &rawScopes, &rawClusters,
+		&t.CreatedAt, &t.ExpiresAt, &t.LastUsed); err != nil {
+		if err != sql.ErrNoRows {
+			log.Warn("Error while scanning rows (ApiToken)")
+		}
+		return nil, err
+	}
+
+	t.Scopes = make([]schema.ApiTokenScope, 0)
+	for _, s := range splitList(rawScopes) {
+		t.Scopes = append(t.Scopes, schema.ApiTokenScope(s))
+	}
+	t.Clusters = splitList(rawClusters)
+	return t, nil
+}
+
+func hashApiToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Add generates a new token for `t` and stores its hash. The token is set in
+// `t` and can not be retrieved later on.
+func (r *ApiTokenRepository) Add(t *schema.ApiToken) error {
+	if t.Name == "" {
+		return errors.New("an api token needs a name")
+	}
+	if len(t.Scopes) == 0 {
+		return errors.New("an api token needs at least one scope")
+	}
+	scopes := make([]string, 0, len(t.Scopes))
+	for _, s := range t.Scopes {
+		if !s.Valid() {
+			return fmt.Errorf("invalid api token scope: %#v", s)
+		}
+		scopes = append(scopes, string(s))
+	}
+	for _, c := range t.Clusters {
+		if c == "" || strings.Contains(c, ",") {
+			return fmt.Errorf("invalid cluster: %#v", c)
+		}
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	t.Token = schema.ApiTokenPrefix + hex.EncodeToString(b)
+
+	q := sq.Insert("api_token").
+		Columns("name", "username", "token_hash", "scopes", "clusters", "created_at", "expires_at", "last_used").
+		Values(t.Name, t.Username, hashApiToken(t.Token), strings.Join(scopes, ","), strings.Join(t.Clusters, ","),
+			t.CreatedAt, t.ExpiresAt, t.LastUsed)
+
+	var err error
+	if t.ID, err = insertReturningId(r.DB, q); err != nil {
+		t.Token = ""
+		log.Errorf("Error while adding api token '%s' of user '%s': %v", t.Name, t.Username, err)
+		return err
+	}
+
+	return nil
+}
+
+// Delete revokes a token.
+func (r *ApiTokenRepository) Delete(id int64) error {
+	res, err := sq.Delete("api_token").Where("api_token.id = ?", id).RunWith(r.stmtCache).Exec()
+	if err != nil {
+		log.Errorf("Error while deleting api token %d: %v", id, err)
+		return err
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *ApiTokenRepository) Get(id int64) (*schema.ApiToken, error) {
+	return scanApiToken(sq.Select(apiTokenColumns...).From("api_token").Where("api_token.id = ?", id).
+		RunWith(r.stmtCache).QueryRow())
+}
+
+// FindByToken returns the stored token matching `token`, sql.ErrNoRows if
+// there is none.
+func (r *ApiTokenRepository) FindByToken(token string) (*schema.ApiToken, error) {
+	return scanApiToken(sq.Select(apiTokenColumns...).From("api_token").
+		Where("api_token.token_hash = ?", hashApiToken(token)).
+		RunWith(r.stmtCache).QueryRow())
+}
+
+// List returns the tokens of a user ordered by name, or the tokens of all
+// users if `username` is empty.
+func (r *ApiTokenRepository) List(username string) ([]*schema.ApiToken, error) {
+	q := sq.Select(apiTokenColumns...).From("api_token").OrderBy("api_token.username", "api_token.name")
+	if username != "" {
+		q = q.Where("api_token.username = ?", username)
+	}
+
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while listing api tokens: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]*schema.ApiToken, 0)
+	for rows.Next() {
+		t, err := scanApiToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+	}
+
+	return tokens, rows.Err()
+}
+
+// UpdateLastUsed sets the time the token was last used at.
+func (r *ApiTokenRepository) UpdateLastUsed(id int64, ts int64) error {
+	if _, err := sq.Update("api_token").Set("last_used", ts).Where("api_token.id = ?", id).
+		RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while updating api token %d: %v", id, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	auditRepoOnce     sync.Once
+	auditRepoInstance *AuditRepository
+)
+
+// The audit log is append-only, the database rejects updates and deletes.
+type AuditRepository struct {
+	DB        *sqlx.DB
+	stmtCache *sq.StmtCache
+	driver    string
+}
+
+func GetAuditRepository() *AuditRepository {
+	auditRepoOnce.Do(func() {
+		db := GetConnection()
+
+		auditRepoInstance = &AuditRepository{
+			DB:     db.DB,
+			driver: db.Driver,
+
+			stmtCache: sq.NewStmtCache(db.DB),
+		}
+	})
+	return auditRepoInstance
+}
+
+type AuditFilter struct {
+	Username string // Exact match
+	Action   string // Substring match
+	Target   string // Substring match
+	From     int64  // Unix timestamp, ignored if 0
+	To       int64  // Unix timestamp, ignored if 0
+}
+
+// AuthMethod describes how the user authenticated, e.g. 'token' or 'session:ldap'.
+func AuthMethod(user *schema.User) string {
+	if user == nil {
+		return ""
+	}
+	if user.AuthType == schema.AuthToken {
+		return "token"
+	}
+
+	switch user.AuthSource {
+	case schema.AuthViaLocalPassword:
+		return "session:local"
+	case schema.AuthViaLDAP:
+		return "session:ldap"
+	case schema.AuthViaToken:
+		return "session:token"
+	case schema.AuthViaOIDC:
+		return "session:oidc"
+	default:
+		return "session"
+	}
+}
+
+// Add appends an entry to the audit log. The time stamp defaults to now.
+func (r *AuditRepository) Add(entry *schema.AuditEntry) error {
+	if entry.TimeStamp == 0 {
+		entry.TimeStamp = time.Now().Unix()
+	}
+
+	q := sq.Insert("audit_log").
+		Columns("time_stamp", "username", "source_ip", "auth_method", "action", "target", "payload", "status").
+		Values(entry.TimeStamp, entry.Username, entry.SourceIP, entry.AuthMethod, entry.Action, entry.Target, entry.Payload, entry.Status)
+
+	if _, err := q.RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while adding audit log entry for '%s': %v", entry.Action, err)
+		return err
+	}
+
+	return nil
+}
+
+// Query returns the audit log entries matching the filter, newest first.
+func (r *AuditRepository) Query(filter *AuditFilter, page *model.PageRequest) ([]*schema.AuditEntry, error) {
+	query := sq.Select("id", "time_stamp", "username", "source_ip", "auth_method", "action", "target", "payload", "status").
+		From("audit_log").OrderBy("audit_log.time_stamp DESC", "audit_log.id DESC")
+
+	if filter != nil {
+		if filter.Username != "" {
+			query = query.Where("audit_log.username = ?", filter.Username)
+		}
+		if filter.Action != "" {
+			query = query.Where("audit_log.action LIKE ?", fmt.Sprint("%", filter.Action, "%"))
+		}
+		if filter.Target != "" {
+			query = query.Where("audit_log.target LIKE ?", fmt.Sprint("%", filter.Target, "%"))
+		}
+		if filter.From != 0 {
+			query = query.Where("audit_log.time_stamp >= ?", filter.From)
+		}
+		if filter.To != 0 {
+			query = query.Where("audit_log.time_stamp <= ?", filter.To)
+		}
+	}
+
+	if page != nil && page.ItemsPerPage != -1 {
+		limit := uint64(page.ItemsPerPage)
+		query = query.Offset((uint64(page.Page) - 1) * limit).Limit(limit)
+	}
+
+	rows, err := query.RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while running query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*schema.AuditEntry, 0, 50)
+	for rows.Next() {
+		e := &schema.AuditEntry{}
+		if err := rows.Scan(&e.ID, &e.TimeStamp, &e.Username, &e.SourceIP, &e.AuthMethod, &e.Action, &e.Target, &e.Payload, &e.Status); err != nil {
+			log.Warn("Error while scanning rows (AuditEntry)")
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
@@ -0,0 +1,462 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	collectionRepoOnce     sync.Once
+	collectionRepoInstance *CollectionRepository
+)
+
+// CollectionRepository stores job collections. A collection is owned by
+// the user who created it and only the owner can change it. The owner can
+// share it read-only with other users and with projects, which grants the
+// users and the managers of the projects access to the jobs of the
+// collection.
+type CollectionRepository struct {
+	DB        *sqlx.DB
+	stmtCache *sq.StmtCache
+	driver    string
+}
+
+func GetCollectionRepository() *CollectionRepository {
+	collectionRepoOnce.Do(func() {
+		db := GetConnection()
+
+		collectionRepoInstance = &CollectionRepository{
+			DB:     db.DB,
+			driver: db.Driver,
+
+			stmtCache: sq.NewStmtCache(db.DB),
+		}
+	})
+	return collectionRepoInstance
+}
+
+var collectionColumns []string = []string{
+	"job_collection.id", "job_collection.name", "job_collection.owner", "job_collection.description",
+	"job_collection.created_at", "job_collection.updated_at",
+	"(SELECT COUNT(*) FROM job_collection_job JOIN job ON job.id = job_collection_job.job_id " +
+		"WHERE job_collection_job.collection_id = job_collection.id AND job.deleted_at IS NULL)",
+}
+
+func scanCollection(row interface{ Scan(...interface{}) error }) (*model.JobCollection, error) {
+	c := &model.JobCollection{SharedUsers: []string{}, SharedProjects: []string{}}
+
+	if err := row.Scan(
+		&c.ID, &c.Name, &c.Owner, &c.Description,
+		&c.CreatedAt, &c.UpdatedAt, &c.NumJobs); err != nil {
+		if err != sql.ErrNoRows {
+			log.Warnf("Error while scanning rows (JobCollection): %v", err)
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Condition on the collections shared with the user, to be used in a
+// subquery selecting from job_collection_share.
+func sharedWithUser(user *schema.User) sq.Or {
+	shared := sq.Or{sq.Eq{
+		"job_collection_share.share_type": "user",
+		"job_collection_share.share_with": user.Username,
+	}}
+	if user.HasRole(schema.RoleManager) && len(user.Projects) != 0 {
+		shared = append(shared, sq.Eq{
+			"job_collection_share.share_type": "project",
+			"job_collection_share.share_with": user.Projects,
+		})
+	}
+	return shared
+}
+
+// Jobs of the collections shared with the user. Placeholders are numbered
+// by the outer query.
+func sharedCollectionJobs(user *schema.User) sq.Sqlizer {
+	return sq.Expr("job.id IN (?)", sq.Select("job_collection_job.job_id").From("job_collection_job").
+		Join("job_collection_share ON job_collection_share.collection_id = job_collection_job.collection_id").
+		Where(sharedWithUser(user)).PlaceholderFormat(sq.Question))
+}
+
+// Restrict the query to the collections owned by or shared with the user.
+// Admins and support staff can see all shared collections.
+func visibleCollections(user *schema.User, q sq.SelectBuilder) sq.SelectBuilder {
+	shares := sq.Select("job_collection_share.collection_id").From("job_collection_share")
+	if !user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
+		shares = shares.Where(sharedWithUser(user))
+	}
+
+	return q.Where(sq.Or{
+		sq.Eq{"job_collection.owner": user.Username},
+		sq.Expr("job_collection.id IN (?)", shares.PlaceholderFormat(sq.Question)),
+	})
+}
+
+// Get returns the collection with the database id `id` if it is visible to
+// the user, together with the users and projects it is shared with.
+func (r *CollectionRepository) Get(user *schema.User, id int64) (*model.JobCollection, error) {
+	if user == nil {
+		return nil, errors.New("job collections require a user")
+	}
+
+	c, err := scanCollection(visibleCollections(user, sq.Select(collectionColumns...).From("job_collection").
+		Where("job_collection.id = ?", id)).RunWith(r.stmtCache).QueryRow())
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("no job collection with id %d", id)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadShares([]*model.JobCollection{c}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// List returns all collections visible to the user, ordered by name.
+func (r *CollectionRepository) List(user *schema.User) ([]*model.JobCollection, error) {
+	if user == nil {
+		return nil, errors.New("job collections require a user")
+	}
+
+	q := visibleCollections(user, sq.Select(collectionColumns...).From("job_collection").
+		OrderBy("job_collection.name ASC", "job_collection.id ASC"))
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		queryString, queryVars, _ := q.ToSql()
+		log.Errorf("Error while running query '%s' %v: %v", queryString, queryVars, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	collections := make([]*model.JobCollection, 0)
+	for rows.Next() {
+		c, err := scanCollection(rows)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return collections, r.loadShares(collections)
+}
+
+func (r *CollectionRepository) loadShares(collections []*model.JobCollection) error {
+	if len(collections) == 0 {
+		return nil
+	}
+
+	byId := make(map[int64]*model.JobCollection, len(collections))
+	ids := make([]int64, 0, len(collections))
+	for _, c := range collections {
+		byId[c.ID] = c
+		ids = append(ids, c.ID)
+	}
+
+	rows, err := sq.Select("job_collection_share.collection_id", "job_collection_share.share_type", "job_collection_share.share_with").
+		From("job_collection_share").Where(sq.Eq{"job_collection_share.collection_id": ids}).
+		OrderBy("job_collection_share.share_with ASC").
+		RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while loading job collection shares: %v", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var shareType, shareWith string
+		if err := rows.Scan(&id, &shareType, &shareWith); err != nil {
+			log.Warn("Error while scanning rows")
+			return err
+		}
+
+		c := byId[id]
+		if shareType == "project" {
+			c.SharedProjects = append(c.SharedProjects, shareWith)
+		} else {
+			c.SharedUsers = append(c.SharedUsers, shareWith)
+		}
+	}
+
+	return rows.Err()
+}
+
+// Returns the collection if the user is allowed to change it.
+func (r *CollectionRepository) owned(user *schema.User, id int64) (*model.JobCollection, error) {
+	c, err := r.Get(user, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.Owner != user.Username {
+		return nil, errors.New("only the owner can change a job collection")
+	}
+
+	return c, nil
+}
+
+func (r *CollectionRepository) touch(id int64) error {
+	if _, err := sq.Update("job_collection").Set("updated_at", time.Now().Unix()).
+		Where("job_collection.id = ?", id).RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while updating job collection %d: %v", id, err)
+		return err
+	}
+	return nil
+}
+
+// Create adds an empty collection owned by the user.
+func (r *CollectionRepository) Create(user *schema.User, name string, description string) (*model.JobCollection, error) {
+	if user == nil {
+		return nil, errors.New("creating a job collection requires a user")
+	}
+	if name == "" {
+		return nil, errors.New("a job collection needs a name")
+	}
+
+	now := time.Now().Unix()
+	c := &model.JobCollection{
+		Name: name, Owner: user.Username, Description: description,
+		SharedUsers: []string{}, SharedProjects: []string{}, CreatedAt: now, UpdatedAt: now,
+	}
+	q := sq.Insert("job_collection").
+		Columns("name", "owner", "description", "created_at", "updated_at").
+		Values(name, user.Username, description, now, now)
+
+	var err error
+	if c.ID, err = insertReturningId(r.DB, q); err != nil {
+		log.Errorf("Error while creating job collection '%s': %v", name, err)
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Update changes the name and the description of a collection. Nil values
+// are left unchanged.
+func (r *CollectionRepository) Update(user *schema.User, id int64, name *string, description *string) (*model.JobCollection, error) {
+	c, err := r.owned(user, id)
+	if err != nil {
+		return nil, err
+	}
+	if name != nil {
+		if *name == "" {
+			return nil, errors.New("a job collection needs a name")
+		}
+		c.Name = *name
+	}
+	if description != nil {
+		c.Description = *description
+	}
+
+	c.UpdatedAt = time.Now().Unix()
+	if _, err := sq.Update("job_collection").
+		Set("name", c.Name).
+		Set("description", c.Description).
+		Set("updated_at", c.UpdatedAt).
+		Where("job_collection.id = ?", id).
+		RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while updating job collection %d: %v", id, err)
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Delete removes a collection. Only the owner or an admin can delete it.
+// The jobs of the collection are not affected.
+func (r *CollectionRepository) Delete(user *schema.User, id int64) error {
+	c, err := r.Get(user, id)
+	if err != nil {
+		return err
+	}
+	if c.Owner != user.Username && !user.HasRole(schema.RoleAdmin) {
+		return errors.New("only the owner or an admin can delete a job collection")
+	}
+
+	if _, err := sq.Delete("job_collection").Where("job_collection.id = ?", id).
+		RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while deleting job collection: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// AddJobs adds the jobs with the database ids `jobIds` to the collection.
+// Only jobs the owner has access to by its roles can be added, so that the
+// jobs of collections shared with the owner cannot be shared again.
+func (r *CollectionRepository) AddJobs(ctx context.Context, id int64, jobIds []int64) (*model.JobCollection, error) {
+	user := GetUserFromContext(ctx)
+	if _, err := r.owned(user, id); err != nil {
+		return nil, err
+	}
+	jobIds = slices.Compact(slices.Sorted(slices.Values(jobIds)))
+	if len(jobIds) == 0 {
+		return r.Get(user, id)
+	}
+
+	q, err := SecurityCheckWithoutShared(ctx, sq.Select("COUNT(job.id)").From("job").Where(sq.Eq{"job.id": jobIds}))
+	if err != nil {
+		return nil, err
+	}
+
+	var count int
+	if err := q.RunWith(r.stmtCache).QueryRow().Scan(&count); err != nil {
+		log.Errorf("Error while checking jobs for job collection %d: %v", id, err)
+		return nil, err
+	}
+	if count != len(jobIds) {
+		return nil, errors.New("jobs do not exist or cannot be added with current authorization")
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting transaction")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().Unix()
+	for _, jobId := range jobIds {
+		var exists int
+		err := sq.Select("1").From("job_collection_job").
+			Where("job_collection_job.collection_id = ?", id).
+			Where("job_collection_job.job_id = ?", jobId).
+			RunWith(tx).QueryRow().Scan(&exists)
+		if err == nil {
+			continue
+		} else if err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		if _, err := sq.Insert("job_collection_job").
+			Columns("collection_id", "job_id", "added_at").
+			Values(id, jobId, now).
+			RunWith(tx).Exec(); err != nil {
+			log.Errorf("Error while adding job %d to job collection %d: %v", jobId, id, err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		log.Warn("Error while committing transaction")
+		return nil, err
+	}
+
+	if err := r.touch(id); err != nil {
+		return nil, err
+	}
+	return r.Get(user, id)
+}
+
+// RemoveJobs removes the jobs with the database ids `jobIds` from the
+// collection.
+func (r *CollectionRepository) RemoveJobs(user *schema.User, id int64, jobIds []int64) (*model.JobCollection, error) {
+	if _, err := r.owned(user, id); err != nil {
+		return nil, err
+	}
+
+	if len(jobIds) != 0 {
+		if _, err := sq.Delete("job_collection_job").
+			Where("job_collection_job.collection_id = ?", id).
+			Where(sq.Eq{"job_collection_job.job_id": jobIds}).
+			RunWith(r.stmtCache).Exec(); err != nil {
+			log.Errorf("Error while removing jobs from job collection %d: %v", id, err)
+			return nil, err
+		}
+		if err := r.touch(id); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.Get(user, id)
+}
+
+// Share replaces the users and projects the collection is shared with.
+func (r *CollectionRepository) Share(user *schema.User, id int64, users []string, projects []string) (*model.JobCollection, error) {
+	if _, err := r.owned(user, id); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting transaction")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := sq.Delete("job_collection_share").Where("job_collection_share.collection_id = ?", id).
+		RunWith(tx).Exec(); err != nil {
+		log.Errorf("Error while sharing job collection %d: %v", id, err)
+		return nil, err
+	}
+
+	shares := map[string][]string{"user": users, "project": projects}
+	for _, shareType := range []string{"user", "project"} {
+		seen := make(map[string]bool)
+		for _, shareWith := range shares[shareType] {
+			if shareWith == "" || seen[shareWith] || (shareType == "user" && shareWith == user.Username) {
+				continue
+			}
+			seen[shareWith] = true
+
+			if _, err := sq.Insert("job_collection_share").
+				Columns("collection_id", "share_type", "share_with").
+				Values(id, shareType, shareWith).
+				RunWith(tx).Exec(); err != nil {
+				log.Errorf("Error while sharing job collection %d: %v", id, err)
+				return nil, err
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		log.Warn("Error while committing transaction")
+		return nil, err
+	}
+
+	if err := r.touch(id); err != nil {
+		return nil, err
+	}
+	return r.Get(user, id)
+}
+
+// CheckFilter returns an error if a filter refers to a collection that is
+// not visible to the user.
+func (r *CollectionRepository) CheckFilter(user *schema.User, filter []*model.JobFilter) error {
+	for _, f := range filter {
+		if f.Collection == nil {
+			continue
+		}
+
+		id, err := strconv.ParseInt(*f.Collection, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid job collection id: %s", *f.Collection)
+		}
+		if _, err := r.Get(user, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
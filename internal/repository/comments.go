@@ -0,0 +1,260 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// Comments on jobs use the same scopes as tags: "global", "admin" or a
+// username for private comments. Unlike global tags, global comments can be
+// written by everybody with access to the job, so that users and support
+// staff can discuss a job. Comments with a time range annotate the metric
+// plots of the job.
+
+var commentColumns []string = []string{
+	"job_comment.id", "job_comment.job_id", "job_comment.parent_id", "job_comment.author", "job_comment.comment_scope",
+	"job_comment.comment_text", "job_comment.metric", "job_comment.range_from", "job_comment.range_to", "job_comment.created_at",
+}
+
+func scanComment(row interface{ Scan(...interface{}) error }) (*schema.JobComment, error) {
+	c := &schema.JobComment{}
+	if err := row.Scan(&c.ID, &c.JobID, &c.ParentID, &c.Author, &c.Scope,
+		&c.Text, &c.Metric, &c.From, &c.To, &c.CreatedAt); err != nil {
+		log.Warnf("Error while scanning rows (JobComment): %v", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+func insertComment(db sqlx.Ext, jobId int64, c *schema.JobComment) (id int64, err error) {
+	q := sq.Insert("job_comment").
+		Columns("job_id", "parent_id", "author", "comment_scope", "comment_text", "metric", "range_from", "range_to", "created_at").
+		Values(jobId, c.ParentID, c.Author, c.Scope, c.Text, c.Metric, c.From, c.To, c.CreatedAt)
+
+	return insertReturningId(db, q)
+}
+
+// AddComment adds a comment to the job with the database id `jobId`. The
+// author of the comment is `user`.
+func (r *JobRepository) AddComment(user *schema.User, jobId int64, comment *schema.JobComment) (*schema.JobComment, error) {
+	j, err := r.FindByIdWithUser(user, jobId)
+	if err != nil {
+		log.Warn("Error while finding job by id")
+		return nil, err
+	}
+
+	// Default to "Global" scope if none defined
+	if comment.Scope == "" {
+		comment.Scope = "global"
+	}
+	if comment.Scope != "global" {
+		writable, err := r.checkScopeAuth(user, "write", comment.Scope)
+		if err != nil {
+			return nil, err
+		}
+		if !writable {
+			return nil, fmt.Errorf("cannot write comment scope with current authorization")
+		}
+	}
+
+	if comment.Text == "" {
+		return nil, errors.New("comment text must not be empty")
+	}
+	if (comment.From == nil) != (comment.To == nil) || (comment.From != nil && *comment.From > *comment.To) {
+		return nil, errors.New("annotated time range needs a start and an end, with the start before the end")
+	}
+	if comment.ParentID != nil {
+		parent, err := r.getComment(*comment.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.JobID != jobId {
+			return nil, errors.New("a reply has to be on the same job as the comment")
+		}
+		readable, err := r.checkScopeAuth(user, "read", parent.Scope)
+		if err != nil {
+			return nil, err
+		}
+		if !readable {
+			return nil, fmt.Errorf("cannot reply to comment with current authorization")
+		}
+	}
+
+	comment.Author = user.Username
+	comment.JobID = jobId
+	comment.CreatedAt = time.Now().Unix()
+	if comment.ID, err = insertComment(r.DB, jobId, comment); err != nil {
+		log.Errorf("Error while inserting comment: %v", err)
+		return nil, err
+	}
+
+	return comment, r.archiveComments(j)
+}
+
+// UpdateComment changes the text of a comment. Only the author can edit it.
+func (r *JobRepository) UpdateComment(user *schema.User, id int64, text string) (*schema.JobComment, error) {
+	comment, err := r.getComment(id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || comment.Author != user.Username {
+		return nil, errors.New("only the author can edit a comment")
+	}
+	if text == "" {
+		return nil, errors.New("comment text must not be empty")
+	}
+
+	j, err := r.FindByIdWithUser(user, comment.JobID)
+	if err != nil {
+		log.Warn("Error while finding job by id")
+		return nil, err
+	}
+
+	if _, err := sq.Update("job_comment").Set("comment_text", text).Where("job_comment.id = ?", id).
+		RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while updating comment: %v", err)
+		return nil, err
+	}
+	comment.Text = text
+
+	return comment, r.archiveComments(j)
+}
+
+// DeleteComment removes a comment and all replies to it. Only the author or
+// an admin can delete a comment.
+func (r *JobRepository) DeleteComment(user *schema.User, id int64) error {
+	comment, err := r.getComment(id)
+	if err != nil {
+		return err
+	}
+	if user == nil || (comment.Author != user.Username && !user.HasRole(schema.RoleAdmin)) {
+		return errors.New("only the author or an admin can delete a comment")
+	}
+
+	j, err := r.FindByIdWithUser(user, comment.JobID)
+	if err != nil {
+		log.Warn("Error while finding job by id")
+		return err
+	}
+
+	if _, err := sq.Delete("job_comment").Where("job_comment.id = ?", id).
+		RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while deleting comment: %v", err)
+		return err
+	}
+
+	return r.archiveComments(j)
+}
+
+func (r *JobRepository) getComment(id int64) (*schema.JobComment, error) {
+	return scanComment(sq.Select(commentColumns...).From("job_comment").
+		Where("job_comment.id = ?", id).RunWith(r.stmtCache).QueryRow())
+}
+
+// GetComments returns the comments on the job with the database id `jobId`
+// that are readable by the user, oldest first.
+func (r *JobRepository) GetComments(user *schema.User, jobId int64) ([]*schema.JobComment, error) {
+	comments, err := r.GetArchiveComments(jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	readable := make([]*schema.JobComment, 0, len(comments))
+	for _, c := range comments {
+		ok, err := r.checkScopeAuth(user, "read", c.Scope)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			readable = append(readable, c)
+		}
+	}
+
+	return readable, nil
+}
+
+// GetArchiveComments returns all comments on the job with the database id
+// `jobId` *regardless of scope* for archiving, oldest first.
+func (r *JobRepository) GetArchiveComments(jobId int64) ([]*schema.JobComment, error) {
+	q := sq.Select(commentColumns...).From("job_comment").
+		Where("job_comment.job_id = ?", jobId).
+		OrderBy("job_comment.created_at ASC", "job_comment.id ASC")
+
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		s, _, _ := q.ToSql()
+		log.Errorf("Error get comments with %s: %v", s, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]*schema.JobComment, 0)
+	for rows.Next() {
+		c, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+func (r *JobRepository) archiveComments(job *schema.Job) error {
+	comments, err := r.GetArchiveComments(job.ID)
+	if err != nil {
+		log.Warn("Error while getting comments for job")
+		return err
+	}
+
+	return archive.UpdateComments(job, comments)
+}
+
+// ImportComments adds the comments of an archived job. Replies have to follow
+// the comments they refer to.
+func (r *JobRepository) ImportComments(jobId int64, comments []*schema.JobComment) error {
+	return importComments(r.DB, jobId, comments)
+}
+
+// TransactionImportComments is ImportComments within the transaction t.
+func (r *JobRepository) TransactionImportComments(t *Transaction, jobId int64, comments []*schema.JobComment) error {
+	return importComments(t.tx, jobId, comments)
+}
+
+func importComments(db sqlx.Ext, jobId int64, comments []*schema.JobComment) error {
+	// The comments get new database ids
+	ids := make(map[int64]int64, len(comments))
+	for _, c := range comments {
+		imported := *c
+		if c.ParentID != nil {
+			parentId, ok := ids[*c.ParentID]
+			if !ok {
+				return fmt.Errorf("parent of comment %d not found", c.ID)
+			}
+			imported.ParentID = &parentId
+		}
+		if imported.Scope == "" {
+			imported.Scope = "global"
+		}
+
+		id, err := insertComment(db, jobId, &imported)
+		if err != nil {
+			log.Errorf("Error while importing comment: %v", err)
+			return err
+		}
+		ids[c.ID] = id
+	}
+
+	return nil
+}
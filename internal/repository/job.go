@@ -53,7 +53,7 @@ func GetJobRepository() *JobRepository {
 var jobColumns []string = []string{
 	"job.id", "job.job_id", "job.hpc_user", "job.project", "job.cluster", "job.subcluster", "job.start_time", "job.cluster_partition", "job.array_job_id",
 	"job.num_nodes", "job.num_hwthreads", "job.num_acc", "job.exclusive", "job.monitoring_status", "job.smt", "job.job_state",
-	"job.duration", "job.walltime", "job.resources", "job.footprint", "job.energy",
+	"job.duration", "job.walltime", "job.resources", "job.footprint", "job.energy", "job.submit_time", "job.eligible_time",
 }
 
 func scanJob(row interface{ Scan(...interface{}) error }) (*schema.Job, error) {
@@ -62,7 +62,7 @@ func scanJob(row interface{ Scan(...interface{}) error }) (*schema.Job, error) {
 	if err := row.Scan(
 		&job.ID, &job.JobID, &job.User, &job.Project, &job.Cluster, &job.SubCluster, &job.StartTimeUnix, &job.Partition, &job.ArrayJobId,
 		&job.NumNodes, &job.NumHWThreads, &job.NumAcc, &job.Exclusive, &job.MonitoringStatus, &job.SMT, &job.State,
-		&job.Duration, &job.Walltime, &job.RawResources, &job.RawFootprint, &job.Energy); err != nil {
+		&job.Duration, &job.Walltime, &job.RawResources, &job.RawFootprint, &job.Energy, &job.SubmitTime, &job.EligibleTime); err != nil {
 		log.Warnf("Error while scanning rows (Job): %v", err)
 		return nil, err
 	}
@@ -98,6 +98,10 @@ func (r *JobRepository) Optimize() error {
 		}
 	case "mysql":
 		log.Info("Optimize currently not supported for mysql driver")
+	case "postgres":
+		if _, err = r.DB.Exec(`VACUUM ANALYZE`); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -108,6 +112,21 @@ func (r *JobRepository) Flush() error {
 
 	switch r.driver {
 	case "sqlite3":
+		if _, err = r.DB.Exec(`DELETE FROM job_search`); err != nil {
+			return err
+		}
+		if _, err = r.DB.Exec(`DELETE FROM job_event`); err != nil {
+			return err
+		}
+		if _, err = r.DB.Exec(`DELETE FROM job_comment`); err != nil {
+			return err
+		}
+		if _, err = r.DB.Exec(`DELETE FROM job_usage_daily`); err != nil {
+			return err
+		}
+		if _, err = r.DB.Exec(`DELETE FROM job_collection_job`); err != nil {
+			return err
+		}
 		if _, err = r.DB.Exec(`DELETE FROM jobtag`); err != nil {
 			return err
 		}
@@ -121,6 +140,21 @@ func (r *JobRepository) Flush() error {
 		if _, err = r.DB.Exec(`SET FOREIGN_KEY_CHECKS = 0`); err != nil {
 			return err
 		}
+		if _, err = r.DB.Exec(`TRUNCATE TABLE job_search`); err != nil {
+			return err
+		}
+		if _, err = r.DB.Exec(`TRUNCATE TABLE job_event`); err != nil {
+			return err
+		}
+		if _, err = r.DB.Exec(`TRUNCATE TABLE job_comment`); err != nil {
+			return err
+		}
+		if _, err = r.DB.Exec(`TRUNCATE TABLE job_usage_daily`); err != nil {
+			return err
+		}
+		if _, err = r.DB.Exec(`TRUNCATE TABLE job_collection_job`); err != nil {
+			return err
+		}
 		if _, err = r.DB.Exec(`TRUNCATE TABLE jobtag`); err != nil {
 			return err
 		}
@@ -133,7 +167,12 @@ func (r *JobRepository) Flush() error {
 		if _, err = r.DB.Exec(`SET FOREIGN_KEY_CHECKS = 1`); err != nil {
 			return err
 		}
+	case "postgres":
+		if _, err = r.DB.Exec(`TRUNCATE TABLE job_search, job_event, job_comment, job_usage_daily, job_collection_job, jobtag, tag, job RESTART IDENTITY CASCADE`); err != nil {
+			return err
+		}
 	}
+	usageRollupCutoff.Store(0)
 
 	return nil
 }
@@ -212,6 +251,7 @@ func (r *JobRepository) UpdateMetadata(job *schema.Job, key, val string) (err er
 	}
 
 	r.cache.Put(cachekey, job.MetaData, len(job.RawMetaData), 24*time.Hour)
+	r.UpdateSearchIndex(job.ID, job.MetaData)
 	return archive.UpdateMetadata(job, job.MetaData)
 }
 
@@ -271,33 +311,223 @@ func (r *JobRepository) FetchEnergyFootprint(job *schema.Job) (map[string]float6
 	return job.EnergyFootprint, nil
 }
 
-func (r *JobRepository) DeleteJobsBefore(startTime int64) (int, error) {
-	var cnt int
-	q := sq.Select("count(*)").From("job").Where("job.start_time < ?", startTime)
-	q.RunWith(r.DB).QueryRow().Scan(cnt)
-	qd := sq.Delete("job").Where("job.start_time < ?", startTime)
-	_, err := qd.RunWith(r.DB).Exec()
+// Jobs are deleted in two steps: Deleting a job marks it as deleted by the
+// user at the current time. Deleted jobs are hidden from all queries and can
+// be restored by admins until they are purged after the grace period.
+
+// DeleteJobsBefore marks all jobs started before `startTime` as deleted by
+// `user`, which is nil if cc-backend deletes them itself. Running jobs are
+// skipped, as they could not be stopped anymore.
+func (r *JobRepository) DeleteJobsBefore(startTime int64, user *schema.User) (int, error) {
+	now := time.Now().Unix()
+	deleted := sq.And{
+		sq.Expr("job.start_time < ?", startTime),
+		sq.Expr("job.deleted_at IS NULL"),
+		sq.Expr("job.job_state != ?", schema.JobStateRunning),
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting transaction")
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Record the job events first, the update changes the deletion state
+	if _, err := sq.Insert("job_event").
+		Columns("job_id", "event", "job_state", "actor", "details", "time_stamp").
+		Select(sq.Select("job.id").
+			Column(fmt.Sprintf("'%s', NULL, ?, '', %d", schema.JobEventDelete, now), actorName(user)).
+			From("job").Where(deleted)).
+		RunWith(tx).Exec(); err != nil {
+		log.Warn("Error while adding job events for deleted jobs")
+		return 0, err
+	}
 
+	qd := sq.Update("job").Set("deleted_at", now).Set("deleted_by", actorName(user)).Where(deleted)
+	res, err := qd.RunWith(tx).Exec()
 	if err != nil {
 		s, _, _ := qd.ToSql()
 		log.Errorf(" DeleteJobsBefore(%d) with %s: error %#v", startTime, s, err)
-	} else {
-		log.Debugf("DeleteJobsBefore(%d): Deleted %d jobs", startTime, cnt)
+		return 0, err
+	}
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		log.Warn("Error while committing transaction")
+		return 0, err
 	}
-	return cnt, err
+
+	log.Debugf("DeleteJobsBefore(%d): Deleted %d jobs", startTime, cnt)
+	if err := r.DeleteUsageRollupBefore(startTime); err != nil {
+		return int(cnt), err
+	}
+	return int(cnt), r.refreshUsageRollup(startTime)
 }
 
-func (r *JobRepository) DeleteJobById(id int64) error {
-	qd := sq.Delete("job").Where("job.id = ?", id)
-	_, err := qd.RunWith(r.DB).Exec()
+// DeleteJobById marks the job with the database id `id` as deleted by `user`.
+// It returns ErrJobRunning if the job is still running.
+func (r *JobRepository) DeleteJobById(id int64, user *schema.User) error {
+	var startTime int64
+	var state schema.JobState
+	if err := sq.Select("job.start_time", "job.job_state").From("job").
+		Where("job.id = ?", id).Where("job.deleted_at IS NULL").
+		RunWith(r.stmtCache).QueryRow().Scan(&startTime, &state); err != nil {
+		return err
+	}
+	if state == schema.JobStateRunning {
+		return ErrJobRunning
+	}
 
-	if err != nil {
+	qd := sq.Update("job").Set("deleted_at", time.Now().Unix()).Set("deleted_by", actorName(user)).
+		Where("job.id = ?", id)
+	if _, err := qd.RunWith(r.stmtCache).Exec(); err != nil {
 		s, _, _ := qd.ToSql()
 		log.Errorf("DeleteJobById(%d) with %s : error %#v", id, s, err)
-	} else {
-		log.Debugf("DeleteJobById(%d): Success", id)
+		return err
+	}
+	log.Debugf("DeleteJobById(%d): Success", id)
+
+	if err := r.AddJobEvent(id, schema.JobEventDelete, "", user, ""); err != nil {
+		return err
+	}
+	return r.refreshUsageRollup(startTime)
+}
+
+// DeletedJob is a job marked as deleted, but not purged yet.
+type DeletedJob struct {
+	DeletedBy string `json:"deletedBy"` // Empty if deleted by cc-backend itself
+	Cluster   string `json:"cluster"`
+	ID        int64  `json:"id"`
+	JobID     int64  `json:"jobId"`
+	StartTime int64  `json:"startTime"`
+	DeletedAt int64  `json:"deletedAt"`
+}
+
+// FindDeletedJobs returns the jobs marked as deleted before `before`, or all
+// deleted jobs if `before` is zero, most recently deleted first.
+func (r *JobRepository) FindDeletedJobs(before int64) ([]*DeletedJob, error) {
+	q := sq.Select("job.id", "job.job_id", "job.cluster", "job.start_time", "job.deleted_at", "job.deleted_by").
+		From("job").Where("job.deleted_at IS NOT NULL").
+		OrderBy("job.deleted_at DESC", "job.id DESC")
+	if before != 0 {
+		q = q.Where("job.deleted_at < ?", before)
+	}
+
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Error("Error while running query")
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := make([]*DeletedJob, 0)
+	for rows.Next() {
+		j := &DeletedJob{}
+		if err := rows.Scan(&j.ID, &j.JobID, &j.Cluster, &j.StartTime, &j.DeletedAt, &j.DeletedBy); err != nil {
+			log.Warn("Error while scanning rows")
+			return nil, err
+		}
+		jobs = append(jobs, j)
 	}
-	return err
+
+	return jobs, rows.Err()
+}
+
+// RestoreJob clears the deletion mark of the job with the database id `id`.
+// It returns sql.ErrNoRows if the job is not deleted or already purged and
+// ErrDuplicateJob if a job with the same job id and cluster that started
+// within a day of it is not deleted.
+func (r *JobRepository) RestoreJob(id int64, user *schema.User) error {
+	var jobId, startTime int64
+	var cluster string
+	if err := sq.Select("job.job_id", "job.cluster", "job.start_time").From("job").
+		Where("job.id = ?", id).Where("job.deleted_at IS NOT NULL").
+		RunWith(r.stmtCache).QueryRow().Scan(&jobId, &cluster, &startTime); err != nil {
+		return err
+	}
+
+	// Same window as the duplicate check when starting jobs
+	var duplicates int
+	if err := sq.Select("count(*)").From("job").
+		Where("job.job_id = ?", jobId).Where("job.cluster = ?", cluster).
+		Where("abs(job.start_time - ?) < 86400", startTime).
+		Where("job.deleted_at IS NULL").
+		RunWith(r.stmtCache).QueryRow().Scan(&duplicates); err != nil {
+		return err
+	}
+	if duplicates > 0 {
+		return ErrDuplicateJob
+	}
+
+	if _, err := sq.Update("job").Set("deleted_at", nil).Set("deleted_by", nil).
+		Where("job.id = ?", id).RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while restoring job %d: %v", id, err)
+		return err
+	}
+
+	if err := r.AddJobEvent(id, schema.JobEventRestore, "", user, ""); err != nil {
+		return err
+	}
+	return r.refreshUsageRollup(startTime)
+}
+
+// PurgeDeletedJobs removes the jobs marked as deleted before `before` from
+// the database and returns them, so that they can be removed from the job
+// archive as well. Jobs restored in the meantime are neither removed nor
+// returned.
+func (r *JobRepository) PurgeDeletedJobs(before int64) ([]*schema.Job, error) {
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting transaction")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := sq.Select(jobColumns...).From("job").
+		Where("job.deleted_at IS NOT NULL").Where("job.deleted_at < ?", before).
+		RunWith(tx).Query()
+	if err != nil {
+		log.Error("Error while running query")
+		return nil, err
+	}
+
+	candidates := make([]*schema.Job, 0)
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			rows.Close()
+			log.Warn("Error while scanning rows")
+			return nil, err
+		}
+		candidates = append(candidates, job)
+	}
+	rows.Close()
+
+	jobs := make([]*schema.Job, 0, len(candidates))
+	for _, job := range candidates {
+		res, err := sq.Delete("job").Where("job.id = ?", job.ID).Where("job.deleted_at IS NOT NULL").
+			RunWith(tx).Exec()
+		if err != nil {
+			log.Errorf("Error while purging deleted job %d: %v", job.ID, err)
+			return nil, err
+		}
+		if cnt, err := res.RowsAffected(); err != nil {
+			return nil, err
+		} else if cnt == 1 {
+			jobs = append(jobs, job)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Warn("Error while committing transaction")
+		return nil, err
+	}
+
+	log.Debugf("PurgeDeletedJobs(%d): Purged %d jobs", before, len(jobs))
+	return jobs, nil
 }
 
 func (r *JobRepository) FindUserOrProjectOrJobname(user *schema.User, searchterm string) (jobid string, username string, project string, jobname string) {
@@ -327,8 +557,10 @@ func (r *JobRepository) FindUserOrProjectOrJobname(user *schema.User, searchterm
 }
 
 var (
-	ErrNotFound  = errors.New("no such jobname, project or user")
-	ErrForbidden = errors.New("not authorized")
+	ErrNotFound     = errors.New("no such jobname, project or user")
+	ErrForbidden    = errors.New("not authorized")
+	ErrJobRunning   = errors.New("running jobs cannot be deleted")
+	ErrDuplicateJob = errors.New("a job with that jobId and cluster started within a day")
 )
 
 func (r *JobRepository) FindColumnValue(user *schema.User, searchterm string, table string, selectColumn string, whereColumn string, isLike bool) (result string, err error) {
@@ -397,7 +629,7 @@ func (r *JobRepository) Partitions(cluster string) ([]string, error) {
 	start := time.Now()
 	partitions := r.cache.Get("partitions:"+cluster, func() (interface{}, time.Duration, int) {
 		parts := []string{}
-		if err = r.DB.Select(&parts, `SELECT DISTINCT job.cluster_partition FROM job WHERE job.cluster = ?;`, cluster); err != nil {
+		if err = r.DB.Select(&parts, r.DB.Rebind(`SELECT DISTINCT job.cluster_partition FROM job WHERE job.cluster = ?;`), cluster); err != nil {
 			return nil, 0, 1000
 		}
 
@@ -417,6 +649,7 @@ func (r *JobRepository) AllocatedNodes(cluster string) (map[string]map[string]in
 	subclusters := make(map[string]map[string]int)
 	rows, err := sq.Select("resources", "subcluster").From("job").
 		Where("job.job_state = 'running'").
+		Where("job.deleted_at IS NULL").
 		Where("job.cluster = ?", cluster).
 		RunWith(r.stmtCache).Query()
 	if err != nil {
@@ -457,14 +690,36 @@ func (r *JobRepository) AllocatedNodes(cluster string) (map[string]map[string]in
 // FIXME: Set duration to requested walltime?
 func (r *JobRepository) StopJobsExceedingWalltimeBy(seconds int) error {
 	start := time.Now()
+	exceeding := sq.And{
+		sq.Expr("job.job_state = 'running'"),
+		sq.Expr("job.walltime > 0"),
+		sq.Expr(fmt.Sprintf("(%d - job.start_time) > (job.walltime + %d)", start.Unix(), seconds)),
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting transaction")
+		return err
+	}
+	defer tx.Rollback()
+
+	// Record the job events first, the update changes the job state
+	if _, err := sq.Insert("job_event").
+		Columns("job_id", "event", "job_state", "actor", "details", "time_stamp").
+		Select(sq.Select("job.id").
+			Column(fmt.Sprintf("'%s', '%s', '', 'exceeded walltime', %d", schema.JobEventStop, schema.JobStateFailed, start.Unix())).
+			From("job").Where(exceeding)).
+		RunWith(tx).Exec(); err != nil {
+		log.Warn("Error while adding job events for jobs exceeding walltime")
+		return err
+	}
+
 	res, err := sq.Update("job").
 		Set("monitoring_status", schema.MonitoringStatusArchivingFailed).
 		Set("duration", 0).
 		Set("job_state", schema.JobStateFailed).
-		Where("job.job_state = 'running'").
-		Where("job.walltime > 0").
-		Where(fmt.Sprintf("(%d - job.start_time) > (job.walltime + %d)", time.Now().Unix(), seconds)).
-		RunWith(r.DB).Exec()
+		Where(exceeding).
+		RunWith(tx).Exec()
 	if err != nil {
 		log.Warn("Error while stopping jobs exceeding walltime")
 		return err
@@ -476,6 +731,11 @@ func (r *JobRepository) StopJobsExceedingWalltimeBy(seconds int) error {
 		return err
 	}
 
+	if err := tx.Commit(); err != nil {
+		log.Warn("Error while committing transaction")
+		return err
+	}
+
 	if rowsAffected > 0 {
 		log.Infof("%d jobs have been marked as failed due to running too long", rowsAffected)
 	}
@@ -487,6 +747,7 @@ func (r *JobRepository) FindRunningJobs(cluster string) ([]*schema.Job, error) {
 	query := sq.Select(jobColumns...).From("job").
 		Where(fmt.Sprintf("job.cluster = '%s'", cluster)).
 		Where("job.job_state = 'running'").
+		Where("job.deleted_at IS NULL").
 		Where("job.duration > 600")
 
 	rows, err := query.RunWith(r.stmtCache).Query()
@@ -15,13 +15,48 @@ import (
 
 const NamedJobInsert string = `INSERT INTO job (
 	job_id, hpc_user, project, cluster, subcluster, cluster_partition, array_job_id, num_nodes, num_hwthreads, num_acc,
-	exclusive, monitoring_status, smt, job_state, start_time, duration, walltime, footprint, energy, energy_footprint, resources, meta_data
+	exclusive, monitoring_status, smt, job_state, submit_time, eligible_time, start_time, duration, walltime, footprint, energy, energy_footprint, resources, meta_data
 ) VALUES (
 	:job_id, :hpc_user, :project, :cluster, :subcluster, :cluster_partition, :array_job_id, :num_nodes, :num_hwthreads, :num_acc,
-  :exclusive, :monitoring_status, :smt, :job_state, :start_time, :duration, :walltime, :footprint,  :energy, :energy_footprint, :resources, :meta_data
+  :exclusive, :monitoring_status, :smt, :job_state, :submit_time, :eligible_time, :start_time, :duration, :walltime, :footprint,  :energy, :energy_footprint, :resources, :meta_data
 );`
 
 func (r *JobRepository) InsertJob(job *schema.JobMeta) (int64, error) {
+	id, err := r.insertJob(job)
+	if err != nil {
+		return 0, err
+	}
+
+	// A failed search index update should not prevent the job from being added
+	r.UpdateSearchIndex(id, job.MetaData)
+	if job.State != schema.JobStateRunning && r.missingInUsageRollup(job.StartTime, job.Duration) {
+		r.refreshUsageRollupOfJobs(job.StartTime)
+	}
+	return id, nil
+}
+
+func (r *JobRepository) insertJob(job *schema.JobMeta) (int64, error) {
+	if r.driver == "postgres" {
+		rows, err := r.DB.NamedQuery(returningId(NamedJobInsert), job)
+		if err != nil {
+			log.Warn("Error while NamedJobInsert")
+			return 0, err
+		}
+		defer rows.Close()
+
+		var id int64
+		if rows.Next() {
+			err = rows.Scan(&id)
+		} else {
+			err = rows.Err()
+		}
+		if err != nil {
+			log.Warn("Error while getting inserted ID")
+			return 0, err
+		}
+		return id, nil
+	}
+
 	res, err := r.DB.NamedExec(NamedJobInsert, job)
 	if err != nil {
 		log.Warn("Error while NamedJobInsert")
@@ -36,29 +71,45 @@ func (r *JobRepository) InsertJob(job *schema.JobMeta) (int64, error) {
 	return id, nil
 }
 
-// Start inserts a new job in the table, returning the unique job ID.
-// Statistics are not transfered!
-func (r *JobRepository) Start(job *schema.JobMeta) (id int64, err error) {
+func encodeJobFields(job *schema.JobMeta) (err error) {
 	job.RawFootprint, err = json.Marshal(job.Footprint)
 	if err != nil {
-		return -1, fmt.Errorf("REPOSITORY/JOB > encoding footprint field failed: %w", err)
+		return fmt.Errorf("REPOSITORY/JOB > encoding footprint field failed: %w", err)
 	}
 
 	job.RawResources, err = json.Marshal(job.Resources)
 	if err != nil {
-		return -1, fmt.Errorf("REPOSITORY/JOB > encoding resources field failed: %w", err)
+		return fmt.Errorf("REPOSITORY/JOB > encoding resources field failed: %w", err)
 	}
 
 	job.RawMetaData, err = json.Marshal(job.MetaData)
 	if err != nil {
-		return -1, fmt.Errorf("REPOSITORY/JOB > encoding metaData field failed: %w", err)
+		return fmt.Errorf("REPOSITORY/JOB > encoding metaData field failed: %w", err)
+	}
+
+	return nil
+}
+
+// Start inserts a new job in the table, returning the unique job ID.
+// Statistics are not transfered! The start is recorded as job event of `user`.
+func (r *JobRepository) Start(user *schema.User, job *schema.JobMeta) (id int64, err error) {
+	if err = encodeJobFields(job); err != nil {
+		return -1, err
 	}
 
-	return r.InsertJob(job)
+	if id, err = r.InsertJob(job); err != nil {
+		return id, err
+	}
+
+	// A failed event insert should not prevent the job from being started
+	r.AddJobEvent(id, schema.JobEventStart, job.State, user, "")
+	return id, nil
 }
 
 // Stop updates the job with the database id jobId using the provided arguments.
+// The stop is recorded as job event of `user`.
 func (r *JobRepository) Stop(
+	user *schema.User,
 	jobId int64,
 	duration int32,
 	state schema.JobState,
@@ -70,6 +121,97 @@ func (r *JobRepository) Stop(
 		Set("monitoring_status", monitoringStatus).
 		Where("job.id = ?", jobId)
 
-	_, err = stmt.RunWith(r.stmtCache).Exec()
-	return
+	if _, err = stmt.RunWith(r.stmtCache).Exec(); err != nil {
+		return err
+	}
+
+	r.AddJobEvent(jobId, schema.JobEventStop, state, user, "")
+	if startTime, missing, err := r.stoppedJobMissingInUsageRollup(r.stmtCache, jobId, duration); err == nil && missing {
+		r.refreshUsageRollupOfJobs(startTime)
+	}
+	return nil
+}
+
+// UpdateRunning writes the walltime, the partition, the subcluster and the
+// resources of `job` to the database, including the numbers of nodes,
+// hwthreads and accelerators. Only running jobs can be updated. The change
+// is recorded as job event of `user` with `details`.
+func (r *JobRepository) UpdateRunning(user *schema.User, job *schema.Job, details string) (err error) {
+	if job.RawResources, err = json.Marshal(job.Resources); err != nil {
+		return fmt.Errorf("REPOSITORY/JOB > encoding resources field failed: %w", err)
+	}
+
+	res, err := sq.Update("job").
+		Set("walltime", job.Walltime).
+		Set("cluster_partition", job.Partition).
+		Set("subcluster", job.SubCluster).
+		Set("resources", job.RawResources).
+		Set("num_nodes", job.NumNodes).
+		Set("num_hwthreads", job.NumHWThreads).
+		Set("num_acc", job.NumAcc).
+		Where("job.id = ?", job.ID).
+		Where("job.job_state = ?", schema.JobStateRunning).
+		RunWith(r.stmtCache).Exec()
+	if err != nil {
+		log.Warnf("Error while updating job, DB ID '%v'", job.ID)
+		return err
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return fmt.Errorf("job %d is not running", job.ID)
+	}
+
+	r.AddJobEvent(job.ID, schema.JobEventUpdate, "", user, details)
+	return nil
+}
+
+// TransactionStart is Start within the transaction t. Unlike Start, any
+// failure is returned, as the transaction is rolled back anyways.
+func (r *JobRepository) TransactionStart(t *Transaction, user *schema.User, job *schema.JobMeta) (id int64, err error) {
+	if err = encodeJobFields(job); err != nil {
+		return -1, err
+	}
+
+	if id, err = r.TransactionAddNamed(t, NamedJobInsert, job); err != nil {
+		return -1, err
+	}
+	if err = r.TransactionUpdateSearchIndex(t, id, job.MetaData); err != nil {
+		return -1, err
+	}
+	if err = r.TransactionAddJobEvent(t, id, schema.JobEventStart, job.State, user, ""); err != nil {
+		return -1, err
+	}
+	if job.State != schema.JobStateRunning && r.missingInUsageRollup(job.StartTime, job.Duration) {
+		t.usageRollupStartTimes = append(t.usageRollupStartTimes, job.StartTime)
+	}
+
+	return id, nil
+}
+
+// TransactionStop is Stop within the transaction t.
+func (r *JobRepository) TransactionStop(
+	t *Transaction,
+	user *schema.User,
+	jobId int64,
+	duration int32,
+	state schema.JobState,
+	monitoringStatus int32,
+) error {
+	stmt := sq.Update("job").
+		Set("job_state", state).
+		Set("duration", duration).
+		Set("monitoring_status", monitoringStatus).
+		Where("job.id = ?", jobId)
+
+	if _, err := stmt.RunWith(t.tx).Exec(); err != nil {
+		return err
+	}
+	if startTime, missing, err := r.stoppedJobMissingInUsageRollup(t.tx, jobId, duration); err != nil {
+		return err
+	} else if missing {
+		t.usageRollupStartTimes = append(t.usageRollupStartTimes, startTime)
+	}
+
+	return r.TransactionAddJobEvent(t, jobId, schema.JobEventStop, state, user, "")
 }
@@ -0,0 +1,206 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// A cursor is the position of a job in a job list ordered by the sort
+// expression and the job id. Unlike pages, cursors stay valid while jobs
+// are inserted. Clients get them base64 encoded and should not rely on
+// their content.
+type jobCursor struct {
+	Order string `json:"o"`           // Sort order the cursor is valid for
+	Value any    `json:"v"`           // Value of the sort expression
+	ID    int64  `json:"id"`          // Database id of the job
+	Prev  bool   `json:"p,omitempty"` // Jobs before the position are requested
+}
+
+func (c *jobCursor) encode() (*string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	cursor := base64.RawURLEncoding.EncodeToString(raw)
+	return &cursor, nil
+}
+
+func decodeJobCursor(cursor string) (*jobCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	c := &jobCursor{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(c); err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	if n, ok := c.Value.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			c.Value = i
+		} else if f, err := n.Float64(); err == nil {
+			c.Value = f
+		}
+	}
+	return c, nil
+}
+
+// QueryJobsCursor returns up to `limit` jobs following the position encoded
+// in `cursor`, or preceding it for cursors returned as previous cursor. An
+// empty cursor starts with the first job. The cursors of the next and the
+// previous jobs are nil if there are no such jobs.
+func (r *JobRepository) QueryJobsCursor(
+	ctx context.Context,
+	filters []*model.JobFilter,
+	order *model.OrderByInput,
+	limit int,
+	cursor string,
+) (jobs []*schema.Job, next *string, prev *string, err error) {
+	return r.queryJobsCursor(ctx, filters, order, limit, cursor, false)
+}
+
+// Same as QueryJobsCursor, the metadata of the jobs is loaded as well if
+// `withMetadata` is set.
+func (r *JobRepository) queryJobsCursor(
+	ctx context.Context,
+	filters []*model.JobFilter,
+	order *model.OrderByInput,
+	limit int,
+	cursor string,
+	withMetadata bool,
+) (jobs []*schema.Job, next *string, prev *string, err error) {
+	if limit <= 0 {
+		return nil, nil, nil, errors.New("cursor pagination requires a positive number of items per page")
+	}
+
+	orderKey, sortExpr, desc := "id", "job.id", false
+	if order != nil {
+		orderKey = fmt.Sprintf("%s:%s:%s", order.Type, order.Field, order.Order)
+		if sortExpr, desc, err = jobSortExpr(order); err != nil {
+			return nil, nil, nil, err
+		}
+		if order.Type != "col" {
+			// Jobs without the footprint value are sorted as zero
+			sortExpr = fmt.Sprintf("COALESCE(%s, 0)", sortExpr)
+		}
+	}
+
+	c := &jobCursor{}
+	if cursor != "" {
+		if c, err = decodeJobCursor(cursor); err != nil {
+			return nil, nil, nil, err
+		}
+		if c.Order != orderKey {
+			return nil, nil, nil, errors.New("cursor does not match the sort order")
+		}
+	}
+
+	columns := append(jobColumns[:len(jobColumns):len(jobColumns)], sortExpr)
+	if withMetadata {
+		columns = append(columns, "job.meta_data")
+	}
+	query, err := SecurityCheck(ctx, sq.Select(columns...).From("job"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if order != nil && order.Type != "col" {
+		// Verify and Search Only in Valid Jsons
+		query = query.Where(jsonValid("meta_data"))
+	}
+	for _, f := range filters {
+		query = BuildWhereClause(f, query)
+	}
+
+	// Jobs before the cursor are queried in reverse order
+	cmp, dir := ">", "ASC"
+	if desc != c.Prev {
+		cmp, dir = "<", "DESC"
+	}
+	if cursor != "" {
+		query = query.Where(fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND job.id %[2]s ?))", sortExpr, cmp),
+			c.Value, c.Value, c.ID)
+	}
+	query = query.OrderBy(sortExpr+" "+dir, "job.id "+dir).Limit(uint64(limit) + 1)
+
+	rows, err := query.RunWith(r.stmtCache).QueryContext(ctx)
+	if err != nil {
+		log.Errorf("Error while running query: %v", err)
+		return nil, nil, nil, err
+	}
+	defer rows.Close()
+
+	jobs = make([]*schema.Job, 0, limit+1)
+	keys := make([]any, 0, limit+1)
+	for rows.Next() {
+		var key any
+		var rawMetaData []byte
+		job, err := scanJob(scannerFunc(func(dest ...interface{}) error {
+			dest = append(dest, &key)
+			if withMetadata {
+				dest = append(dest, &rawMetaData)
+			}
+			return rows.Scan(dest...)
+		}))
+		if err != nil {
+			log.Warn("Error while scanning rows (Jobs)")
+			return nil, nil, nil, err
+		}
+		if b, ok := key.([]byte); ok {
+			key = string(b)
+		}
+		if len(rawMetaData) != 0 {
+			if err := json.Unmarshal(rawMetaData, &job.MetaData); err != nil {
+				log.Warn("Error while unmarshaling raw metadata json")
+				return nil, nil, nil, err
+			}
+		}
+		jobs, keys = append(jobs, job), append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	more := len(jobs) > limit
+	if more {
+		jobs, keys = jobs[:limit], keys[:limit]
+	}
+	if c.Prev {
+		slices.Reverse(jobs)
+		slices.Reverse(keys)
+	}
+	if len(jobs) == 0 {
+		return jobs, nil, nil, nil
+	}
+
+	// Coming from the previous jobs, there are jobs after these and vice versa
+	if (!c.Prev && more) || (c.Prev && cursor != "") {
+		last := len(jobs) - 1
+		if next, err = (&jobCursor{Order: orderKey, Value: keys[last], ID: jobs[last].ID}).encode(); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if (c.Prev && more) || (!c.Prev && cursor != "") {
+		if prev, err = (&jobCursor{Order: orderKey, Value: keys[0], ID: jobs[0].ID, Prev: true}).encode(); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return jobs, next, prev, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Name of the user recorded as actor of a job event. Events triggered by
+// cc-backend itself have no actor.
+func actorName(user *schema.User) string {
+	if user == nil {
+		return ""
+	}
+	return user.Username
+}
+
+// AddJobEvent records an event in the lifecycle of the job with the database
+// id `jobId`. `state` is the job state after the event and empty if the event
+// did not change it.
+func (r *JobRepository) AddJobEvent(
+	jobId int64,
+	event schema.JobEventType,
+	state schema.JobState,
+	user *schema.User,
+	details string,
+) error {
+	return addJobEvent(r.stmtCache, jobId, event, state, user, details)
+}
+
+// TransactionAddJobEvent is AddJobEvent within the transaction t.
+func (r *JobRepository) TransactionAddJobEvent(
+	t *Transaction,
+	jobId int64,
+	event schema.JobEventType,
+	state schema.JobState,
+	user *schema.User,
+	details string,
+) error {
+	return addJobEvent(t.tx, jobId, event, state, user, details)
+}
+
+func addJobEvent(
+	db sq.BaseRunner,
+	jobId int64,
+	event schema.JobEventType,
+	state schema.JobState,
+	user *schema.User,
+	details string,
+) error {
+	var jobState sql.NullString
+	if state != "" {
+		jobState = sql.NullString{String: string(state), Valid: true}
+	}
+
+	q := sq.Insert("job_event").
+		Columns("job_id", "event", "job_state", "actor", "details", "time_stamp").
+		Values(jobId, event, jobState, actorName(user), details, time.Now().Unix())
+
+	if _, err := q.RunWith(db).Exec(); err != nil {
+		s, _, _ := q.ToSql()
+		log.Errorf("Error adding job event with %s: %v", s, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetJobEvents returns the events of the job with the database id `jobId`
+// ordered by time.
+func (r *JobRepository) GetJobEvents(jobId int64) ([]*schema.JobEvent, error) {
+	q := sq.Select("id", "job_id", "event", "job_state", "actor", "details", "time_stamp").
+		From("job_event").Where("job_event.job_id = ?", jobId).
+		OrderBy("job_event.time_stamp ASC", "job_event.id ASC")
+
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		s, _, _ := q.ToSql()
+		log.Errorf("Error get job events with %s: %v", s, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*schema.JobEvent, 0)
+	for rows.Next() {
+		event := &schema.JobEvent{}
+		var state sql.NullString
+		if err := rows.Scan(&event.ID, &event.JobID, &event.Event, &state, &event.Actor, &event.Details, &event.TimeStamp); err != nil {
+			log.Warn("Error while scanning rows (JobEvent)")
+			return nil, err
+		}
+		if state.Valid {
+			jobState := schema.JobState(state.String)
+			event.State = &jobState
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
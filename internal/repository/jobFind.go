@@ -28,7 +28,8 @@ func (r *JobRepository) Find(
 ) (*schema.Job, error) {
 	start := time.Now()
 	q := sq.Select(jobColumns...).From("job").
-		Where("job.job_id = ?", *jobId)
+		Where("job.job_id = ?", *jobId).
+		Where("job.deleted_at IS NULL")
 
 	if cluster != nil {
 		q = q.Where("job.cluster = ?", *cluster)
@@ -43,20 +44,42 @@ func (r *JobRepository) Find(
 	return scanJob(q.RunWith(r.stmtCache).QueryRow())
 }
 
-// Find executes a SQL query to find a specific batch job.
-// The job is queried using the batch job id, the cluster name,
-// and the start time of the job in UNIX epoch time seconds.
-// It returns a pointer to a schema.Job data structure and an error variable.
-// To check if no job was found test err == sql.ErrNoRows
+// FindAll executes a SQL query to find all batch jobs matching the batch job
+// id and optionally the cluster name and the start time of the job in UNIX
+// epoch time seconds. Jobs marked as deleted are not returned.
+// It returns a slice of pointers to schema.Job data structures and an error variable.
 func (r *JobRepository) FindAll(
 	jobId *int64,
 	cluster *string,
 	startTime *int64,
+) ([]*schema.Job, error) {
+	return r.findAll(jobId, cluster, startTime, false)
+}
+
+// FindAllWithDeleted works like FindAll, but also returns the jobs marked as
+// deleted that are not purged yet. Use it to check for duplicates, as
+// deleted jobs can be restored.
+func (r *JobRepository) FindAllWithDeleted(
+	jobId *int64,
+	cluster *string,
+	startTime *int64,
+) ([]*schema.Job, error) {
+	return r.findAll(jobId, cluster, startTime, true)
+}
+
+func (r *JobRepository) findAll(
+	jobId *int64,
+	cluster *string,
+	startTime *int64,
+	withDeleted bool,
 ) ([]*schema.Job, error) {
 	start := time.Now()
 	q := sq.Select(jobColumns...).From("job").
 		Where("job.job_id = ?", *jobId)
 
+	if !withDeleted {
+		q = q.Where("job.deleted_at IS NULL")
+	}
 	if cluster != nil {
 		q = q.Where("job.cluster = ?", *cluster)
 	}
@@ -69,6 +92,7 @@ func (r *JobRepository) FindAll(
 		log.Error("Error while running query")
 		return nil, err
 	}
+	defer rows.Close()
 
 	jobs := make([]*schema.Job, 0, 10)
 	for rows.Next() {
@@ -122,7 +146,7 @@ func (r *JobRepository) FindByIdWithUser(user *schema.User, jobId int64) (*schem
 // To check if no job was found test err == sql.ErrNoRows
 func (r *JobRepository) FindByIdDirect(jobId int64) (*schema.Job, error) {
 	q := sq.Select(jobColumns...).
-		From("job").Where("job.id = ?", jobId)
+		From("job").Where("job.id = ?", jobId).Where("job.deleted_at IS NULL")
 	return scanJob(q.RunWith(r.stmtCache).QueryRow())
 }
 
@@ -155,7 +179,8 @@ func (r *JobRepository) IsJobOwner(jobId int64, startTime int64, user string, cl
 		Where("job.job_id = ?", jobId).
 		Where("job.hpc_user = ?", user).
 		Where("job.cluster = ?", cluster).
-		Where("job.start_time = ?", startTime)
+		Where("job.start_time = ?", startTime).
+		Where("job.deleted_at IS NULL")
 
 	_, err := scanJob(q.RunWith(r.stmtCache).QueryRow())
 	return err != sql.ErrNoRows
@@ -29,31 +29,9 @@ func (r *JobRepository) QueryJobs(
 		return nil, qerr
 	}
 
-	if order != nil {
-		field := toSnakeCase(order.Field)
-		if order.Type == "col" {
-			// "col": Fixed column name query
-			switch order.Order {
-			case model.SortDirectionEnumAsc:
-				query = query.OrderBy(fmt.Sprintf("job.%s ASC", field))
-			case model.SortDirectionEnumDesc:
-				query = query.OrderBy(fmt.Sprintf("job.%s DESC", field))
-			default:
-				return nil, errors.New("REPOSITORY/QUERY > invalid sorting order for column")
-			}
-		} else {
-			// "foot": Order by footprint JSON field values
-			// Verify and Search Only in Valid Jsons
-			query = query.Where("JSON_VALID(meta_data)")
-			switch order.Order {
-			case model.SortDirectionEnumAsc:
-				query = query.OrderBy(fmt.Sprintf("JSON_EXTRACT(footprint, \"$.%s\") ASC", field))
-			case model.SortDirectionEnumDesc:
-				query = query.OrderBy(fmt.Sprintf("JSON_EXTRACT(footprint, \"$.%s\") DESC", field))
-			default:
-				return nil, errors.New("REPOSITORY/QUERY > invalid sorting order for footprint")
-			}
-		}
+	query, err := orderJobs(query, order)
+	if err != nil {
+		return nil, err
 	}
 
 	if page != nil && page.ItemsPerPage != -1 {
@@ -85,6 +63,103 @@ func (r *JobRepository) QueryJobs(
 	return jobs, nil
 }
 
+// Time a job waited in the queue, zero if the submit time is unknown
+const waitTimeExpr = "(CASE WHEN job.submit_time > 0 THEN job.start_time - job.submit_time ELSE 0 END)"
+
+// Sort expression of the order and whether it is descending
+func jobSortExpr(order *model.OrderByInput) (string, bool, error) {
+	field := toSnakeCase(order.Field)
+	if order.Type == "col" {
+		// "col": Fixed column name query
+		expr := fmt.Sprintf("job.%s", field)
+		if field == "wait_time" {
+			expr = waitTimeExpr
+		}
+		switch order.Order {
+		case model.SortDirectionEnumAsc:
+			return expr, false, nil
+		case model.SortDirectionEnumDesc:
+			return expr, true, nil
+		default:
+			return "", false, errors.New("REPOSITORY/QUERY > invalid sorting order for column")
+		}
+	}
+
+	// "foot": Order by footprint JSON field values
+	switch order.Order {
+	case model.SortDirectionEnumAsc:
+		return jsonNumber("footprint", field), false, nil
+	case model.SortDirectionEnumDesc:
+		return jsonNumber("footprint", field), true, nil
+	default:
+		return "", false, errors.New("REPOSITORY/QUERY > invalid sorting order for footprint")
+	}
+}
+
+func orderJobs(query sq.SelectBuilder, order *model.OrderByInput) (sq.SelectBuilder, error) {
+	if order == nil {
+		return query, nil
+	}
+
+	expr, desc, err := jobSortExpr(order)
+	if err != nil {
+		return query, err
+	}
+	if order.Type != "col" {
+		// Verify and Search Only in Valid Jsons
+		query = query.Where(jsonValid("meta_data"))
+	}
+	if desc {
+		return query.OrderBy(expr + " DESC"), nil
+	}
+	return query.OrderBy(expr + " ASC"), nil
+}
+
+type scannerFunc func(dest ...interface{}) error
+
+func (f scannerFunc) Scan(dest ...interface{}) error { return f(dest...) }
+
+// Number of jobs StreamJobs queries at once
+var streamJobsBatchSize = 1000
+
+// StreamJobs calls `fn` for each job matching the filters, without loading
+// all jobs into memory. The jobs are queried in batches using cursors, so
+// that no connection is held while `fn` is running. The metadata of the jobs
+// is only loaded if `withMetadata` is set. Returning an error from `fn` stops
+// the iteration.
+func (r *JobRepository) StreamJobs(
+	ctx context.Context,
+	filters []*model.JobFilter,
+	order *model.OrderByInput,
+	withMetadata bool,
+	fn func(job *schema.Job) error,
+) error {
+	cursor := ""
+	for {
+		jobs, next, _, err := r.queryJobsCursor(ctx, filters, order, streamJobsBatchSize, cursor, withMetadata)
+		if err != nil {
+			return err
+		}
+
+		for _, job := range jobs {
+			if err := fn(job); err != nil {
+				return err
+			}
+		}
+
+		if next == nil {
+			return nil
+		}
+		cursor = *next
+	}
+}
+
+// ValidateJobOrder returns an error if jobs can not be sorted by `order`.
+func ValidateJobOrder(order *model.OrderByInput) error {
+	_, _, err := jobSortExpr(order)
+	return err
+}
+
 func (r *JobRepository) CountJobs(
 	ctx context.Context,
 	filters []*model.JobFilter,
@@ -108,25 +183,37 @@ func (r *JobRepository) CountJobs(
 }
 
 func SecurityCheckWithUser(user *schema.User, query sq.SelectBuilder) (sq.SelectBuilder, error) {
+	return securityCheckWithUser(user, query, true)
+}
+
+func securityCheckWithUser(user *schema.User, query sq.SelectBuilder, withShared bool) (sq.SelectBuilder, error) {
 	if user == nil {
 		var qnil sq.SelectBuilder
 		return qnil, fmt.Errorf("user context is nil")
 	}
 
+	// Deleted jobs are hidden until they are restored or purged
+	query = query.Where("job.deleted_at IS NULL")
+
+	personal := sq.Or{sq.Eq{"job.hpc_user": user.Username}}
+	if withShared {
+		personal = append(personal, sharedCollectionJobs(user))
+	}
+
 	switch {
 	case len(user.Roles) == 1 && user.HasRole(schema.RoleApi): // API-User : All jobs
 		return query, nil
 	case user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}): // Admin & Support : All jobs
 		return query, nil
-	case user.HasRole(schema.RoleManager): // Manager : Add filter for managed projects' jobs only + personal jobs
+	case user.HasRole(schema.RoleManager): // Manager : Add filter for managed projects' jobs only + personal jobs + shared jobs
 		if len(user.Projects) != 0 {
-			return query.Where(sq.Or{sq.Eq{"job.project": user.Projects}, sq.Eq{"job.hpc_user": user.Username}}), nil
+			return query.Where(append(sq.Or{sq.Eq{"job.project": user.Projects}}, personal...)), nil
 		} else {
 			log.Debugf("Manager-User '%s' has no defined projects to lookup! Query only personal jobs ...", user.Username)
-			return query.Where("job.hpc_user = ?", user.Username), nil
+			return query.Where(personal), nil
 		}
-	case user.HasRole(schema.RoleUser): // User : Only personal jobs
-		return query.Where("job.hpc_user = ?", user.Username), nil
+	case user.HasRole(schema.RoleUser): // User : Only personal jobs + jobs of collections shared with the user
+		return query.Where(personal), nil
 	default: // No known Role, return error
 		var qnil sq.SelectBuilder
 		return qnil, fmt.Errorf("user has no or unknown roles")
@@ -134,9 +221,28 @@ func SecurityCheckWithUser(user *schema.User, query sq.SelectBuilder) (sq.Select
 }
 
 func SecurityCheck(ctx context.Context, query sq.SelectBuilder) (sq.SelectBuilder, error) {
+	return securityCheck(ctx, query, true)
+}
+
+// SecurityCheckWithoutShared is SecurityCheck without the jobs the user can
+// only see because of job collections shared with them.
+func SecurityCheckWithoutShared(ctx context.Context, query sq.SelectBuilder) (sq.SelectBuilder, error) {
+	return securityCheck(ctx, query, false)
+}
+
+func securityCheck(ctx context.Context, query sq.SelectBuilder, withShared bool) (sq.SelectBuilder, error) {
 	user := GetUserFromContext(ctx)
 
-	return SecurityCheckWithUser(user, query)
+	query, err := securityCheckWithUser(user, query, withShared)
+	if err != nil {
+		return query, err
+	}
+
+	// API tokens restricted to clusters only see the jobs of these clusters
+	if token := GetApiTokenFromContext(ctx); token != nil && len(token.Clusters) != 0 {
+		query = query.Where(sq.Eq{"job.cluster": token.Clusters})
+	}
+	return query, nil
 }
 
 // Build a sq.SelectBuilder out of a schema.JobFilter.
@@ -160,6 +266,9 @@ func BuildWhereClause(filter *model.JobFilter, query sq.SelectBuilder) sq.Select
 	if filter.JobName != nil {
 		query = buildMetaJsonCondition("jobName", filter.JobName, query)
 	}
+	if filter.Search != nil {
+		query = buildSearchCondition(*filter.Search, query)
+	}
 	if filter.Cluster != nil {
 		query = buildStringCondition("job.cluster", filter.Cluster, query)
 	}
@@ -169,6 +278,12 @@ func BuildWhereClause(filter *model.JobFilter, query sq.SelectBuilder) sq.Select
 	if filter.StartTime != nil {
 		query = buildTimeCondition("job.start_time", filter.StartTime, query)
 	}
+	if filter.SubmitTime != nil {
+		query = buildTimeCondition("job.submit_time", filter.SubmitTime, query).Where("job.submit_time > 0")
+	}
+	if filter.WaitTime != nil {
+		query = buildIntCondition(waitTimeExpr, filter.WaitTime, query).Where("job.submit_time > 0")
+	}
 	if filter.Duration != nil {
 		query = buildIntCondition("job.duration", filter.Duration, query)
 	}
@@ -204,6 +319,9 @@ func BuildWhereClause(filter *model.JobFilter, query sq.SelectBuilder) sq.Select
 			query = buildFloatJsonCondition(ms.MetricName, ms.Range, query)
 		}
 	}
+	if filter.Collection != nil {
+		query = query.Where("job.id IN (SELECT job_collection_job.job_id FROM job_collection_job WHERE job_collection_job.collection_id = ?)", *filter.Collection)
+	}
 	return query
 }
 
@@ -246,8 +364,8 @@ func buildTimeCondition(field string, cond *schema.TimeRange, query sq.SelectBui
 
 func buildFloatJsonCondition(condName string, condRange *model.FloatRange, query sq.SelectBuilder) sq.SelectBuilder {
 	// Verify and Search Only in Valid Jsons
-	query = query.Where("JSON_VALID(footprint)")
-	return query.Where("JSON_EXTRACT(footprint, \"$."+condName+"\") BETWEEN ? AND ?", condRange.From, condRange.To)
+	query = query.Where(jsonValid("footprint"))
+	return query.Where(jsonNumber("footprint", condName)+" BETWEEN ? AND ?", condRange.From, condRange.To)
 }
 
 func buildStringCondition(field string, cond *model.StringInput, query sq.SelectBuilder) sq.SelectBuilder {
@@ -276,26 +394,61 @@ func buildStringCondition(field string, cond *model.StringInput, query sq.Select
 
 func buildMetaJsonCondition(jsonField string, cond *model.StringInput, query sq.SelectBuilder) sq.SelectBuilder {
 	// Verify and Search Only in Valid Jsons
-	query = query.Where("JSON_VALID(meta_data)")
+	query = query.Where(jsonValid("meta_data"))
+	field := jsonText("meta_data", jsonField)
 	// add "AND" Sql query Block for field match
 	if cond.Eq != nil {
-		return query.Where("JSON_EXTRACT(meta_data, \"$."+jsonField+"\") = ?", *cond.Eq)
+		return query.Where(field+" = ?", *cond.Eq)
 	}
 	if cond.Neq != nil {
-		return query.Where("JSON_EXTRACT(meta_data, \"$."+jsonField+"\") != ?", *cond.Neq)
+		return query.Where(field+" != ?", *cond.Neq)
 	}
 	if cond.StartsWith != nil {
-		return query.Where("JSON_EXTRACT(meta_data, \"$."+jsonField+"\") LIKE ?", fmt.Sprint(*cond.StartsWith, "%"))
+		return query.Where(field+" LIKE ?", fmt.Sprint(*cond.StartsWith, "%"))
 	}
 	if cond.EndsWith != nil {
-		return query.Where("JSON_EXTRACT(meta_data, \"$."+jsonField+"\") LIKE ?", fmt.Sprint("%", *cond.EndsWith))
+		return query.Where(field+" LIKE ?", fmt.Sprint("%", *cond.EndsWith))
 	}
 	if cond.Contains != nil {
-		return query.Where("JSON_EXTRACT(meta_data, \"$."+jsonField+"\") LIKE ?", fmt.Sprint("%", *cond.Contains, "%"))
+		return query.Where(field+" LIKE ?", fmt.Sprint("%", *cond.Contains, "%"))
 	}
 	return query
 }
 
+// Driver of the database connection, used by query builders without access
+// to a repository.
+func dbDriver() string {
+	if dbConnInstance == nil {
+		return ""
+	}
+	return dbConnInstance.Driver
+}
+
+// Condition matching rows where the JSON column col holds valid JSON. Postgres
+// stores JSON columns as JSONB which is always valid.
+func jsonValid(col string) string {
+	if dbDriver() == "postgres" {
+		return col + " IS NOT NULL"
+	}
+	return fmt.Sprintf("JSON_VALID(%s)", col)
+}
+
+// Expression for the numeric value of field in the JSON column col.
+func jsonNumber(col string, field string) string {
+	if dbDriver() == "postgres" {
+		return fmt.Sprintf("CAST(%s->>'%s' AS DOUBLE PRECISION)", col, strings.ReplaceAll(field, "'", "''"))
+	}
+	return fmt.Sprintf("JSON_EXTRACT(%s, \"$.%s\")", col, field)
+}
+
+// Expression for the string value of field in the JSON column col.
+func jsonText(col string, field string) string {
+	if dbDriver() == "postgres" {
+		return fmt.Sprintf("%s->>'%s'", col, strings.ReplaceAll(field, "'", "''"))
+	}
+	return fmt.Sprintf("JSON_EXTRACT(%s, \"$.%s\")", col, field)
+}
+
 var (
 	matchFirstCap = regexp.MustCompile("(.)([A-Z][a-z]+)")
 	matchAllCap   = regexp.MustCompile("([a-z0-9])([A-Z])")
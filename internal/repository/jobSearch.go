@@ -0,0 +1,171 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// The full-text job search uses the table job_search with one row per job
+// holding the job name, the job script and the values of the meta data keys
+// configured in `search-meta-keys`. Depending on the driver it is an FTS5
+// table (sqlite3), a table with a FULLTEXT index (mysql) or a table with a
+// generated tsvector column (postgres).
+
+func searchDocument(metaData map[string]string) (jobName, jobScript, meta string) {
+	values := make([]string, 0, len(config.Keys.SearchMetaKeys))
+	for _, key := range config.Keys.SearchMetaKeys {
+		if v, ok := metaData[key]; ok && v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return metaData["jobName"], metaData["jobScript"], strings.Join(values, "\n")
+}
+
+func (r *JobRepository) updateSearchIndex(db sqlx.Execer, id int64, metaData map[string]string) error {
+	jobName, jobScript, meta := searchDocument(metaData)
+
+	switch r.driver {
+	case "sqlite3":
+		if _, err := db.Exec(`DELETE FROM job_search WHERE rowid = ?`, id); err != nil {
+			return err
+		}
+		_, err := db.Exec(`INSERT INTO job_search (rowid, job_name, job_script, meta_data) VALUES (?, ?, ?, ?)`,
+			id, jobName, jobScript, meta)
+		return err
+	case "mysql":
+		_, err := db.Exec(`REPLACE INTO job_search (job_id, job_name, job_script, meta_data) VALUES (?, ?, ?, ?)`,
+			id, jobName, jobScript, meta)
+		return err
+	case "postgres":
+		_, err := db.Exec(`INSERT INTO job_search (job_id, job_name, job_script, meta_data) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (job_id) DO UPDATE SET job_name = EXCLUDED.job_name, job_script = EXCLUDED.job_script, meta_data = EXCLUDED.meta_data`,
+			id, jobName, jobScript, meta)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSearchIndex replaces the full-text search entry of the job with the
+// database id `id`.
+func (r *JobRepository) UpdateSearchIndex(id int64, metaData map[string]string) error {
+	if err := r.updateSearchIndex(r.DB, id, metaData); err != nil {
+		log.Warnf("Error while updating search index for job, DB ID '%v'", id)
+		return err
+	}
+
+	return nil
+}
+
+// TransactionUpdateSearchIndex is UpdateSearchIndex within the transaction t.
+func (r *JobRepository) TransactionUpdateSearchIndex(t *Transaction, id int64, metaData map[string]string) error {
+	if err := r.updateSearchIndex(t.tx, id, metaData); err != nil {
+		log.Warnf("Error while updating search index for job, DB ID '%v'", id)
+		return err
+	}
+
+	return nil
+}
+
+// RebuildSearchIndex replaces the full-text search entries of all jobs. The
+// migration adding the search table cannot know the configured
+// `search-meta-keys`, so it is called after migrating the database. It has to
+// be called as well after changing `search-meta-keys`. It returns the number
+// of indexed jobs.
+func (r *JobRepository) RebuildSearchIndex() (int, error) {
+	const batchSize = 1000
+	cnt := 0
+	lastId := int64(0)
+	for {
+		rows, err := sq.Select("job.id", "job.meta_data").From("job").
+			Where("job.id > ?", lastId).OrderBy("job.id").Limit(batchSize).
+			RunWith(r.DB).Query()
+		if err != nil {
+			log.Error("Error while running query")
+			return cnt, err
+		}
+
+		metaData := make(map[int64]map[string]string, batchSize)
+		ids := make([]int64, 0, batchSize)
+		for rows.Next() {
+			var id int64
+			var raw []byte
+			if err := rows.Scan(&id, &raw); err != nil {
+				rows.Close()
+				log.Warn("Error while scanning rows")
+				return cnt, err
+			}
+			meta := map[string]string{}
+			if len(raw) != 0 {
+				if err := json.Unmarshal(raw, &meta); err != nil {
+					log.Warnf("Error while unmarshaling metadata for job, DB ID '%v'", id)
+				}
+			}
+			metaData[id] = meta
+			ids = append(ids, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return cnt, err
+		}
+		if len(ids) == 0 {
+			return cnt, nil
+		}
+
+		t, err := r.TransactionInit()
+		if err != nil {
+			return cnt, err
+		}
+		for _, id := range ids {
+			if err := r.TransactionUpdateSearchIndex(t, id, metaData[id]); err != nil {
+				t.tx.Rollback()
+				return cnt, err
+			}
+		}
+		if err := r.TransactionEnd(t); err != nil {
+			return cnt, err
+		}
+
+		cnt += len(ids)
+		lastId = ids[len(ids)-1]
+	}
+}
+
+// Split text into words and quote them, so that a search does not depend on
+// the query syntax of the database. All words have to match.
+func searchTerms(text string) []string {
+	words := strings.Fields(strings.ReplaceAll(text, `"`, " "))
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		terms = append(terms, `"`+w+`"`)
+	}
+
+	return terms
+}
+
+func buildSearchCondition(text string, query sq.SelectBuilder) sq.SelectBuilder {
+	terms := searchTerms(text)
+	if len(terms) == 0 {
+		return query
+	}
+
+	switch dbDriver() {
+	case "mysql":
+		return query.Where("job.id IN (SELECT job_id FROM job_search WHERE MATCH (job_name, job_script, meta_data) AGAINST (? IN BOOLEAN MODE))",
+			"+"+strings.Join(terms, " +"))
+	case "postgres":
+		return query.Where("job.id IN (SELECT job_id FROM job_search WHERE document @@ plainto_tsquery('simple', ?))", text)
+	default:
+		return query.Where("job.id IN (SELECT rowid FROM job_search WHERE job_search MATCH ?)", strings.Join(terms, " "))
+	}
+}
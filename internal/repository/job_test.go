@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -71,3 +73,158 @@ func TestGetTags(t *testing.T) {
 		t.Errorf("wrong tag count \ngot: %d \nwant: 0", counts["bandwidth"])
 	}
 }
+
+func TestSearch(t *testing.T) {
+	r := setup(t)
+
+	for search, want := range map[string]int{
+		"conda astro_dl":       3,
+		"BS_512_NF_70_job_1":   1,
+		"ams_pipeline":         3,
+		`"unknown" executable`: 0,
+	} {
+		count, err := r.CountJobs(getContext(t), []*model.JobFilter{{Search: &search}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if count != want {
+			t.Errorf("wrong number of jobs for search '%s'\ngot: %d \nwant: %d", search, count, want)
+		}
+	}
+}
+
+func TestRebuildSearchIndex(t *testing.T) {
+	r := setup(t)
+	search := "NodeList=a0224"
+	count := func() int {
+		count, err := r.CountJobs(getContext(t), []*model.JobFilter{{Search: &search}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return count
+	}
+
+	if got := count(); got != 0 {
+		t.Fatalf("expected meta data to be unsearchable, got %d jobs", got)
+	}
+
+	config.Keys.SearchMetaKeys = []string{"slurmInfo"}
+	t.Cleanup(func() {
+		config.Keys.SearchMetaKeys = nil
+		if _, err := r.RebuildSearchIndex(); err != nil {
+			t.Error(err)
+		}
+	})
+	cnt, err := r.RebuildSearchIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cnt != 6 {
+		t.Errorf("wrong number of indexed jobs\ngot: %d \nwant: 6", cnt)
+	}
+	if got := count(); got != 1 {
+		t.Errorf("wrong number of jobs for search '%s'\ngot: %d \nwant: 1", search, got)
+	}
+}
+
+func TestQueryJobsCursor(t *testing.T) {
+	r := setup(t)
+	ctx := getContext(t)
+	order := &model.OrderByInput{Field: "startTime", Type: "col", Order: model.SortDirectionEnumDesc}
+
+	count, err := r.CountJobs(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Walk through all jobs, two at a time
+	var pages [][]*schema.Job
+	var prevCursors []*string
+	seen := make(map[int64]bool)
+	cursor := ""
+	for {
+		jobs, next, prev, err := r.QueryJobsCursor(ctx, nil, order, 2, cursor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (cursor == "") != (prev == nil) {
+			t.Errorf("expected a previous cursor on all but the first page")
+		}
+		for _, job := range jobs {
+			if seen[job.ID] {
+				t.Errorf("job %d returned twice", job.ID)
+			}
+			seen[job.ID] = true
+		}
+		pages, prevCursors = append(pages, jobs), append(prevCursors, prev)
+
+		if next == nil {
+			break
+		}
+		cursor = *next
+	}
+	if len(seen) != count {
+		t.Errorf("wrong number of jobs\ngot: %d \nwant: %d", len(seen), count)
+	}
+
+	var last *schema.Job
+	for _, jobs := range pages {
+		for _, job := range jobs {
+			if last != nil && (job.StartTimeUnix > last.StartTimeUnix ||
+				(job.StartTimeUnix == last.StartTimeUnix && job.ID > last.ID)) {
+				t.Errorf("job %d not in sort order after job %d", job.ID, last.ID)
+			}
+			last = job
+		}
+	}
+
+	// The previous cursor of the last page leads back to the page before
+	n := len(pages) - 1
+	jobs, next, _, err := r.QueryJobsCursor(ctx, nil, order, 2, *prevCursors[n])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next == nil || len(jobs) != len(pages[n-1]) {
+		t.Fatalf("unexpected previous jobs: %d", len(jobs))
+	}
+	for i := range jobs {
+		if jobs[i].ID != pages[n-1][i].ID {
+			t.Errorf("wrong previous job\ngot: %d \nwant: %d", jobs[i].ID, pages[n-1][i].ID)
+		}
+	}
+
+	other := &model.OrderByInput{Field: "duration", Type: "col", Order: model.SortDirectionEnumAsc}
+	if _, _, _, err := r.QueryJobsCursor(ctx, nil, other, 2, *prevCursors[n]); err == nil {
+		t.Error("expected cursor of another sort order to fail")
+	}
+}
+
+func TestStreamJobs(t *testing.T) {
+	r := setup(t)
+	ctx := getContext(t)
+	order := &model.OrderByInput{Field: "startTime", Type: "col", Order: model.SortDirectionEnumDesc}
+
+	defer func(size int) { streamJobsBatchSize = size }(streamJobsBatchSize)
+	streamJobsBatchSize = 4
+
+	want, _, _, err := r.QueryJobsCursor(ctx, nil, order, 100, "")
+	noErr(t, err)
+
+	got := make([]int64, 0)
+	noErr(t, r.StreamJobs(ctx, nil, order, true, func(job *schema.Job) error {
+		if job.MetaData == nil {
+			t.Errorf("expected metadata of job %d", job.ID)
+		}
+		got = append(got, job.ID)
+		return nil
+	}))
+	if len(got) != len(want) {
+		t.Fatalf("wrong number of jobs\ngot: %d \nwant: %d", len(got), len(want))
+	}
+	for i, job := range want {
+		if got[i] != job.ID {
+			t.Errorf("wrong order at %d\ngot: %d \nwant: %d", i, got[i], job.ID)
+		}
+	}
+}
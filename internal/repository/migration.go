@@ -12,11 +12,12 @@ import (
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/mysql"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/golang-migrate/migrate/v4/database/sqlite3"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 )
 
-const Version uint = 8
+const Version uint = 21
 
 //go:embed migrations/*
 var migrationFiles embed.FS
@@ -53,6 +54,20 @@ func checkDBVersion(backend string, db *sql.DB) error {
 		if err != nil {
 			return err
 		}
+	case "postgres":
+		driver, err := postgres.WithInstance(db, &postgres.Config{})
+		if err != nil {
+			return err
+		}
+		d, err := iofs.New(migrationFiles, "migrations/postgres")
+		if err != nil {
+			return err
+		}
+
+		m, err = migrate.NewWithInstance("iofs", d, "postgres", driver)
+		if err != nil {
+			return err
+		}
 	default:
 		log.Fatalf("unsupported database backend: %s", backend)
 	}
@@ -101,6 +116,16 @@ func getMigrateInstance(backend string, db string) (m *migrate.Migrate, err erro
 		if err != nil {
 			return m, err
 		}
+	case "postgres":
+		d, err := iofs.New(migrationFiles, "migrations/postgres")
+		if err != nil {
+			return m, err
+		}
+
+		m, err = migrate.NewWithSourceInstance("iofs", d, db)
+		if err != nil {
+			return m, err
+		}
 	default:
 		log.Fatalf("unsupported database backend: %s", backend)
 	}
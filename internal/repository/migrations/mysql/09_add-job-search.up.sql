@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS job_search (
+    job_id     INTEGER PRIMARY KEY,
+    job_name   TEXT,
+    job_script MEDIUMTEXT,
+    meta_data  TEXT,
+    FULLTEXT (job_name, job_script, meta_data),
+    FOREIGN KEY (job_id) REFERENCES job (id) ON DELETE CASCADE);
+
+-- The values of the configured search-meta-keys are unknown here. cc-backend
+-- -migrate-db rebuilds the index after the migration, see RebuildSearchIndex.
+INSERT INTO job_search (job_id, job_name, job_script, meta_data)
+SELECT id, IFNULL(JSON_UNQUOTE(JSON_EXTRACT(meta_data, '$.jobName')), ''), IFNULL(JSON_UNQUOTE(JSON_EXTRACT(meta_data, '$.jobScript')), ''), ''
+FROM job WHERE JSON_VALID(meta_data);
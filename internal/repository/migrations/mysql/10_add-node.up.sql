@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS node (
+    id           INTEGER AUTO_INCREMENT PRIMARY KEY,
+    hostname     VARCHAR(255) NOT NULL,
+    cluster      VARCHAR(255) NOT NULL,
+    subcluster   VARCHAR(255) NOT NULL DEFAULT '',
+    node_state   VARCHAR(255) NOT NULL DEFAULT 'unknown'
+    CHECK(node_state IN ('allocated', 'reserved', 'idle', 'mixed',
+            'down', 'drain', 'maintenance', 'unknown')),
+    health_state VARCHAR(255) NOT NULL DEFAULT 'unknown'
+    CHECK(health_state IN ('full', 'partial', 'failed', 'unknown')),
+    reason       TEXT NOT NULL,
+    state_since  BIGINT NOT NULL DEFAULT 0, -- Unix timestamp
+    time_stamp   BIGINT NOT NULL DEFAULT 0, -- Unix timestamp
+    health_info  JSON,
+    inventory    JSON,
+    UNIQUE (hostname, cluster));
+
+CREATE TABLE IF NOT EXISTS node_state_history (
+    id           INTEGER AUTO_INCREMENT PRIMARY KEY,
+    node_id      INTEGER NOT NULL,
+    node_state   VARCHAR(255) NOT NULL,
+    health_state VARCHAR(255) NOT NULL,
+    reason       TEXT NOT NULL,
+    time_stamp   BIGINT NOT NULL, -- Unix timestamp
+    FOREIGN KEY (node_id) REFERENCES node (id) ON DELETE CASCADE);
+
+CREATE INDEX nodes_cluster ON node (cluster);
+CREATE INDEX nodes_cluster_state ON node (cluster, node_state);
+CREATE INDEX node_states_node_time ON node_state_history (node_id, time_stamp);
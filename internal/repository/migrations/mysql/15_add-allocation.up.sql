@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS allocation (
+    id          INTEGER AUTO_INCREMENT PRIMARY KEY,
+    project     VARCHAR(255) NOT NULL,
+    cluster     VARCHAR(255) NOT NULL,
+    start_time  BIGINT NOT NULL, -- Unix timestamp
+    end_time    BIGINT NOT NULL, -- Unix timestamp
+    core_hours  REAL, -- NULL if not limited
+    acc_hours   REAL, -- NULL if not limited
+    energy      REAL, -- kWh, NULL if not limited
+    CHECK(start_time < end_time));
+
+CREATE INDEX allocations_project_cluster ON allocation (project, cluster);
@@ -0,0 +1,21 @@
+-- Daily aggregates of finished jobs, columns named as in the job table
+CREATE TABLE IF NOT EXISTS job_usage_daily (
+    start_time     BIGINT NOT NULL, -- Unix timestamp of the day (UTC) the jobs started
+    cluster        VARCHAR(50) NOT NULL,
+    hpc_user       VARCHAR(50) NOT NULL,
+    project        VARCHAR(50) NOT NULL,
+    total_jobs     BIGINT NOT NULL,
+    total_walltime BIGINT NOT NULL, -- Seconds
+    total_nodes    BIGINT NOT NULL,
+    node_seconds   BIGINT NOT NULL,
+    total_cores    BIGINT NOT NULL,
+    core_seconds   BIGINT NOT NULL,
+    total_accs     BIGINT NOT NULL,
+    acc_seconds    BIGINT NOT NULL,
+    energy         REAL NOT NULL, -- kWh
+    updated_at     BIGINT NOT NULL, -- Unix timestamp, includes jobs stopped before
+    PRIMARY KEY (start_time, cluster, hpc_user, project));
+
+CREATE INDEX job_usage_daily_cluster ON job_usage_daily (cluster, start_time);
+CREATE INDEX job_usage_daily_user ON job_usage_daily (hpc_user, start_time);
+CREATE INDEX job_usage_daily_project ON job_usage_daily (project, start_time);
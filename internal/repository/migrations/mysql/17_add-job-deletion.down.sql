@@ -0,0 +1,4 @@
+DROP INDEX jobs_deleted_at ON job;
+
+ALTER TABLE job DROP deleted_by;
+ALTER TABLE job DROP deleted_at;
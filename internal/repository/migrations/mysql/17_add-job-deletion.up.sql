@@ -0,0 +1,4 @@
+ALTER TABLE job ADD COLUMN deleted_at BIGINT DEFAULT NULL; -- Unix timestamp, NULL if not deleted
+ALTER TABLE job ADD COLUMN deleted_by VARCHAR(255) DEFAULT NULL;
+
+CREATE INDEX jobs_deleted_at ON job (deleted_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS job_collection_share;
+DROP TABLE IF EXISTS job_collection_job;
+DROP TABLE IF EXISTS job_collection;
@@ -0,0 +1,2 @@
+ALTER TABLE job DROP eligible_time;
+ALTER TABLE job DROP submit_time;
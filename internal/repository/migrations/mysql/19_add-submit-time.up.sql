@@ -0,0 +1,2 @@
+ALTER TABLE job ADD COLUMN submit_time BIGINT NOT NULL DEFAULT 0; -- Unix timestamp, 0 if unknown
+ALTER TABLE job ADD COLUMN eligible_time BIGINT NOT NULL DEFAULT 0; -- Unix timestamp, 0 if unknown
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS api_token (
+    id         INTEGER AUTO_INCREMENT PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    username   VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE, -- Hex encoded SHA-256 of the token
+    scopes     TEXT NOT NULL, -- Comma separated list of scopes
+    clusters   TEXT NOT NULL, -- Comma separated list of clusters, empty for all clusters
+    created_at BIGINT NOT NULL, -- Unix timestamp
+    expires_at BIGINT NOT NULL DEFAULT 0, -- Unix timestamp, 0 if the token does not expire
+    last_used  BIGINT NOT NULL DEFAULT 0, -- Unix timestamp
+    UNIQUE (username, name),
+    FOREIGN KEY (username) REFERENCES hpc_user (username) ON DELETE CASCADE);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS jobtag;
+DROP TABLE IF EXISTS tag;
+DROP TABLE IF EXISTS job;
+DROP TABLE IF EXISTS configuration;
+DROP TABLE IF EXISTS hpc_user;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS job_search (
+    job_id     BIGINT PRIMARY KEY,
+    job_name   TEXT,
+    job_script TEXT,
+    meta_data  TEXT,
+    document   TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple',
+        coalesce(job_name, '') || ' ' || coalesce(job_script, '') || ' ' || coalesce(meta_data, ''))) STORED,
+    FOREIGN KEY (job_id) REFERENCES job (id) ON DELETE CASCADE);
+
+CREATE INDEX IF NOT EXISTS job_search_document ON job_search USING GIN (document);
+
+-- The values of the configured search-meta-keys are unknown here. cc-backend
+-- -migrate-db rebuilds the index after the migration, see RebuildSearchIndex.
+INSERT INTO job_search (job_id, job_name, job_script, meta_data)
+SELECT id, coalesce(meta_data->>'jobName', ''), coalesce(meta_data->>'jobScript', ''), ''
+FROM job WHERE meta_data IS NOT NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS job_event (
+    id         BIGSERIAL PRIMARY KEY,
+    job_id     BIGINT NOT NULL,
+    event      VARCHAR(25) NOT NULL,
+    job_state  VARCHAR(25),
+    actor      VARCHAR(255) NOT NULL DEFAULT '',
+    details    TEXT NOT NULL DEFAULT '',
+    time_stamp BIGINT NOT NULL, -- Unix timestamp
+    FOREIGN KEY (job_id) REFERENCES job (id) ON DELETE CASCADE);
+
+CREATE INDEX IF NOT EXISTS job_events_job_time ON job_event (job_id, time_stamp);
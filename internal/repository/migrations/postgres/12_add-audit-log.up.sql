@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id          BIGSERIAL PRIMARY KEY,
+    time_stamp  BIGINT NOT NULL, -- Unix timestamp
+    username    VARCHAR(255) NOT NULL DEFAULT '',
+    source_ip   VARCHAR(255) NOT NULL DEFAULT '',
+    auth_method VARCHAR(255) NOT NULL DEFAULT '',
+    action      VARCHAR(255) NOT NULL,
+    target      TEXT NOT NULL DEFAULT '',
+    payload     TEXT NOT NULL DEFAULT '',
+    status      INT NOT NULL DEFAULT 0);
+
+CREATE INDEX IF NOT EXISTS audit_log_time ON audit_log (time_stamp);
+CREATE INDEX IF NOT EXISTS audit_log_user_time ON audit_log (username, time_stamp);
+
+-- The audit log is append-only
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_change BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_log
+FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
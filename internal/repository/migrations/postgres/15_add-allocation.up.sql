@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS allocation (
+    id          BIGSERIAL PRIMARY KEY,
+    project     VARCHAR(255) NOT NULL,
+    cluster     VARCHAR(50) NOT NULL,
+    start_time  BIGINT NOT NULL, -- Unix timestamp
+    end_time    BIGINT NOT NULL, -- Unix timestamp
+    core_hours  DOUBLE PRECISION, -- NULL if not limited
+    acc_hours   DOUBLE PRECISION, -- NULL if not limited
+    energy      DOUBLE PRECISION, -- kWh, NULL if not limited
+    CHECK(start_time < end_time));
+
+CREATE INDEX IF NOT EXISTS allocations_project_cluster ON allocation (project, cluster);
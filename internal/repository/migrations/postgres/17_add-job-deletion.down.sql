@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS jobs_deleted_at;
+
+ALTER TABLE job DROP COLUMN deleted_by;
+ALTER TABLE job DROP COLUMN deleted_at;
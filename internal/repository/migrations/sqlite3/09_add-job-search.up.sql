@@ -0,0 +1,14 @@
+-- FTS5 requires the sqlite_fts5 build tag of go-sqlite3, see the Makefile
+CREATE VIRTUAL TABLE IF NOT EXISTS job_search USING fts5(job_name, job_script, meta_data);
+
+-- The values of the configured search-meta-keys are unknown here. cc-backend
+-- -migrate-db rebuilds the index after the migration, see RebuildSearchIndex.
+INSERT INTO job_search (rowid, job_name, job_script, meta_data)
+SELECT id, ifnull(json_extract(meta_data, '$.jobName'), ''), ifnull(json_extract(meta_data, '$.jobScript'), ''), ''
+FROM job WHERE json_valid(meta_data);
+
+-- Virtual tables do not support foreign keys
+CREATE TRIGGER IF NOT EXISTS job_search_delete AFTER DELETE ON job
+BEGIN
+    DELETE FROM job_search WHERE rowid = old.id;
+END;
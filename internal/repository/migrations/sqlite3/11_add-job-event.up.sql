@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS job_event (
+    id         INTEGER PRIMARY KEY,
+    job_id     INTEGER NOT NULL,
+    event      VARCHAR(255) NOT NULL,
+    job_state  VARCHAR(255),
+    actor      VARCHAR(255) NOT NULL DEFAULT '',
+    details    TEXT NOT NULL DEFAULT '',
+    time_stamp BIGINT NOT NULL, -- Unix timestamp
+    FOREIGN KEY (job_id) REFERENCES job (id) ON DELETE CASCADE);
+
+CREATE INDEX IF NOT EXISTS job_events_job_time ON job_event (job_id, time_stamp);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id          INTEGER PRIMARY KEY,
+    time_stamp  BIGINT NOT NULL, -- Unix timestamp
+    username    VARCHAR(255) NOT NULL DEFAULT '',
+    source_ip   VARCHAR(255) NOT NULL DEFAULT '',
+    auth_method VARCHAR(255) NOT NULL DEFAULT '',
+    action      VARCHAR(255) NOT NULL,
+    target      TEXT NOT NULL DEFAULT '',
+    payload     TEXT NOT NULL DEFAULT '',
+    status      INT NOT NULL DEFAULT 0);
+
+CREATE INDEX IF NOT EXISTS audit_log_time ON audit_log (time_stamp);
+CREATE INDEX IF NOT EXISTS audit_log_user_time ON audit_log (username, time_stamp);
+
+-- The audit log is append-only
+CREATE TRIGGER IF NOT EXISTS audit_log_no_update BEFORE UPDATE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit log is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS audit_log_no_delete BEFORE DELETE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit log is append-only');
+END;
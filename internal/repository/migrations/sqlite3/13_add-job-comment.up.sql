@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS job_comment (
+    id            INTEGER PRIMARY KEY,
+    job_id        INTEGER NOT NULL,
+    parent_id     INTEGER,
+    author        VARCHAR(255) NOT NULL,
+    comment_scope VARCHAR(255) NOT NULL DEFAULT 'global',
+    comment_text  TEXT NOT NULL,
+    metric        VARCHAR(255) NOT NULL DEFAULT '',
+    range_from    BIGINT, -- Unix timestamp
+    range_to      BIGINT, -- Unix timestamp
+    created_at    BIGINT NOT NULL, -- Unix timestamp
+    FOREIGN KEY (job_id) REFERENCES job (id) ON DELETE CASCADE,
+    FOREIGN KEY (parent_id) REFERENCES job_comment (id) ON DELETE CASCADE);
+
+CREATE INDEX IF NOT EXISTS job_comments_job ON job_comment (job_id, created_at);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS job_filter (
+    id          INTEGER PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    owner       VARCHAR(255) NOT NULL,
+    shared      VARCHAR(255) NOT NULL DEFAULT 'private'
+    CHECK(shared IN ('private', 'project', 'global')),
+    project     VARCHAR(255) NOT NULL DEFAULT '',
+    filter      TEXT NOT NULL, -- JSON
+    created_at  BIGINT NOT NULL, -- Unix timestamp
+    updated_at  BIGINT NOT NULL, -- Unix timestamp
+    UNIQUE (owner, name));
+
+CREATE INDEX IF NOT EXISTS job_filters_name ON job_filter (name);
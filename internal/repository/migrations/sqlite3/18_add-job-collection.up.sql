@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS job_collection (
+    id          INTEGER PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    owner       VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    created_at  BIGINT NOT NULL, -- Unix timestamp
+    updated_at  BIGINT NOT NULL, -- Unix timestamp
+    UNIQUE (owner, name));
+
+CREATE TABLE IF NOT EXISTS job_collection_job (
+    collection_id INTEGER NOT NULL,
+    job_id        INTEGER NOT NULL,
+    added_at      BIGINT NOT NULL, -- Unix timestamp
+    PRIMARY KEY (collection_id, job_id),
+    FOREIGN KEY (collection_id) REFERENCES job_collection (id) ON DELETE CASCADE,
+    FOREIGN KEY (job_id) REFERENCES job (id) ON DELETE CASCADE);
+
+CREATE TABLE IF NOT EXISTS job_collection_share (
+    collection_id INTEGER NOT NULL,
+    share_type    VARCHAR(255) NOT NULL
+    CHECK(share_type IN ('user', 'project')),
+    share_with    VARCHAR(255) NOT NULL,
+    PRIMARY KEY (collection_id, share_type, share_with),
+    FOREIGN KEY (collection_id) REFERENCES job_collection (id) ON DELETE CASCADE);
+
+CREATE INDEX IF NOT EXISTS job_collection_jobs_job ON job_collection_job (job_id);
+CREATE INDEX IF NOT EXISTS job_collection_shares_with ON job_collection_share (share_type, share_with);
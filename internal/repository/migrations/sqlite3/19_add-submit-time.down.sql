@@ -0,0 +1,2 @@
+ALTER TABLE job DROP COLUMN eligible_time;
+ALTER TABLE job DROP COLUMN submit_time;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS webhook (
+    id         INTEGER PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL UNIQUE,
+    url        TEXT NOT NULL,
+    secret     VARCHAR(255) NOT NULL,
+    events     TEXT NOT NULL, -- Comma separated list of event types
+    active     SMALLINT NOT NULL DEFAULT 1,
+    created_by VARCHAR(255) NOT NULL,
+    created_at BIGINT NOT NULL); -- Unix timestamp
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id              INTEGER PRIMARY KEY,
+    webhook_id      INTEGER NOT NULL,
+    event           VARCHAR(255) NOT NULL,
+    payload         TEXT NOT NULL,
+    status          VARCHAR(255) NOT NULL
+    CHECK(status IN ('pending', 'delivered', 'failed')),
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt    BIGINT NOT NULL, -- Unix timestamp
+    response_status INTEGER NOT NULL DEFAULT 0,
+    error           TEXT NOT NULL,
+    created_at      BIGINT NOT NULL, -- Unix timestamp
+    delivered_at    BIGINT NOT NULL DEFAULT 0, -- Unix timestamp
+    FOREIGN KEY (webhook_id) REFERENCES webhook (id) ON DELETE CASCADE);
+
+CREATE INDEX IF NOT EXISTS webhook_delivery_due ON webhook_delivery (status, next_attempt);
+CREATE INDEX IF NOT EXISTS webhook_delivery_webhook ON webhook_delivery (webhook_id, created_at);
@@ -0,0 +1,380 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	nodeRepoOnce     sync.Once
+	nodeRepoInstance *NodeRepository
+)
+
+type NodeRepository struct {
+	DB        *sqlx.DB
+	stmtCache *sq.StmtCache
+	driver    string
+}
+
+func GetNodeRepository() *NodeRepository {
+	nodeRepoOnce.Do(func() {
+		db := GetConnection()
+
+		nodeRepoInstance = &NodeRepository{
+			DB:     db.DB,
+			driver: db.Driver,
+
+			stmtCache: sq.NewStmtCache(db.DB),
+		}
+	})
+	return nodeRepoInstance
+}
+
+var nodeColumns []string = []string{
+	"node.id", "node.hostname", "node.cluster", "node.subcluster", "node.node_state", "node.health_state",
+	"node.reason", "node.state_since", "node.time_stamp", "node.health_info", "node.inventory",
+}
+
+func scanNode(row interface{ Scan(...interface{}) error }) (*schema.Node, error) {
+	node := &schema.Node{}
+
+	if err := row.Scan(
+		&node.ID, &node.Hostname, &node.Cluster, &node.SubCluster, &node.NodeState, &node.HealthState,
+		&node.Reason, &node.StateSince, &node.TimeStamp, &node.RawHealthInfo, &node.RawInventory); err != nil {
+		log.Warnf("Error while scanning rows (Node): %v", err)
+		return nil, err
+	}
+
+	if len(node.RawHealthInfo) > 0 {
+		if err := json.Unmarshal(node.RawHealthInfo, &node.HealthInfo); err != nil {
+			log.Warn("Error while unmarshaling raw health info json")
+			return nil, err
+		}
+	}
+	node.RawHealthInfo = nil
+
+	if len(node.RawInventory) > 0 {
+		if err := json.Unmarshal(node.RawInventory, &node.Inventory); err != nil {
+			log.Warn("Error while unmarshaling raw inventory json")
+			return nil, err
+		}
+	}
+	node.RawInventory = nil
+
+	return node, nil
+}
+
+// UpdateNodeStates inserts or updates the nodes identified by their hostname
+// and cluster in one transaction. If the node state, health state or reason
+// of a node changed, the change is recorded in the node state history and the
+// node is returned. Health info and inventory are only replaced if they are
+// set. Updates older than the stored state of a node are skipped.
+func (r *NodeRepository) UpdateNodeStates(nodes []*schema.Node) (changed []*schema.Node, err error) {
+	for _, node := range nodes {
+		if err := prepareNodeState(node); err != nil {
+			return nil, fmt.Errorf("node %s: %w", node.Hostname, err)
+		}
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting node state transaction")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	changed = make([]*schema.Node, 0)
+	for _, node := range nodes {
+		nodeChanged, err := updateNodeState(tx, node)
+		if err != nil {
+			return nil, err
+		}
+		if nodeChanged {
+			changed = append(changed, node)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+// Set the defaults of a node state update and encode its JSON fields.
+func prepareNodeState(node *schema.Node) (err error) {
+	if node.TimeStamp == 0 {
+		node.TimeStamp = time.Now().Unix()
+	}
+	if node.NodeState == "" {
+		node.NodeState = schema.NodeStateUnknown
+	}
+	if node.HealthState == "" {
+		node.HealthState = schema.HealthStateUnknown
+	}
+	if !node.NodeState.Valid() {
+		return fmt.Errorf("REPOSITORY/NODE > invalid node state: %#v", node.NodeState)
+	}
+	if !node.HealthState.Valid() {
+		return fmt.Errorf("REPOSITORY/NODE > invalid health state: %#v", node.HealthState)
+	}
+
+	if node.HealthInfo != nil {
+		if node.RawHealthInfo, err = json.Marshal(node.HealthInfo); err != nil {
+			return fmt.Errorf("REPOSITORY/NODE > encoding health info field failed: %w", err)
+		}
+	}
+	if node.Inventory != nil {
+		if node.RawInventory, err = json.Marshal(node.Inventory); err != nil {
+			return fmt.Errorf("REPOSITORY/NODE > encoding inventory field failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func updateNodeState(tx *sqlx.Tx, node *schema.Node) (changed bool, err error) {
+	var state schema.NodeState
+	var health schema.HealthState
+	var reason string
+	var timeStamp int64
+	changed = true
+	err = tx.QueryRowx(tx.Rebind(`SELECT id, node_state, health_state, reason, time_stamp FROM node WHERE hostname = ? AND cluster = ?`),
+		node.Hostname, node.Cluster).Scan(&node.ID, &state, &health, &reason, &timeStamp)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		node.StateSince = node.TimeStamp
+		q := sq.Insert("node").
+			Columns("hostname", "cluster", "subcluster", "node_state", "health_state", "reason",
+				"state_since", "time_stamp", "health_info", "inventory").
+			Values(node.Hostname, node.Cluster, node.SubCluster, node.NodeState, node.HealthState, node.Reason,
+				node.StateSince, node.TimeStamp, node.RawHealthInfo, node.RawInventory)
+		if node.ID, err = insertReturningId(tx, q); err != nil {
+			log.Errorf("Error while inserting node '%s' of cluster '%s': %v", node.Hostname, node.Cluster, err)
+			return false, err
+		}
+	case err != nil:
+		log.Warnf("Error while querying node '%s' of cluster '%s'", node.Hostname, node.Cluster)
+		return false, err
+	case node.TimeStamp < timeStamp:
+		log.Debugf("Skipping outdated state of node '%s' of cluster '%s'", node.Hostname, node.Cluster)
+		return false, nil
+	default:
+		changed = state != node.NodeState || health != node.HealthState || reason != node.Reason
+		q := sq.Update("node").
+			Set("subcluster", node.SubCluster).
+			Set("time_stamp", node.TimeStamp).
+			Where("node.id = ?", node.ID)
+		if changed {
+			q = q.Set("node_state", node.NodeState).
+				Set("health_state", node.HealthState).
+				Set("reason", node.Reason).
+				Set("state_since", node.TimeStamp)
+		}
+		if node.RawHealthInfo != nil {
+			q = q.Set("health_info", node.RawHealthInfo)
+		}
+		if node.RawInventory != nil {
+			q = q.Set("inventory", node.RawInventory)
+		}
+
+		query, args, qerr := q.ToSql()
+		if qerr != nil {
+			return false, qerr
+		}
+		if _, err = tx.Exec(query, args...); err != nil {
+			log.Errorf("Error while updating node '%s' of cluster '%s': %v", node.Hostname, node.Cluster, err)
+			return false, err
+		}
+	}
+
+	if changed {
+		query, args, qerr := sq.Insert("node_state_history").
+			Columns("node_id", "node_state", "health_state", "reason", "time_stamp").
+			Values(node.ID, node.NodeState, node.HealthState, node.Reason, node.TimeStamp).ToSql()
+		if qerr != nil {
+			return false, qerr
+		}
+		if _, err = tx.Exec(query, args...); err != nil {
+			log.Errorf("Error while adding node state history entry: %v", err)
+			return false, err
+		}
+	}
+
+	return changed, nil
+}
+
+// GetNode returns the node with the database id `id`.
+func (r *NodeRepository) GetNode(id int64) (*schema.Node, error) {
+	q := sq.Select(nodeColumns...).From("node").Where("node.id = ?", id)
+	node, err := scanNode(q.RunWith(r.stmtCache).QueryRow())
+	if err != nil {
+		log.Warnf("Error while querying node, DB ID '%v'", id)
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// FindNode returns the node with the given hostname in the given cluster.
+func (r *NodeRepository) FindNode(cluster string, hostname string) (*schema.Node, error) {
+	q := sq.Select(nodeColumns...).From("node").
+		Where("node.cluster = ?", cluster).Where("node.hostname = ?", hostname)
+	node, err := scanNode(q.RunWith(r.stmtCache).QueryRow())
+	if err != nil {
+		log.Warnf("Error while querying node '%s' of cluster '%s'", hostname, cluster)
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// QueryNodes returns all nodes matching the filters, ordered by cluster and
+// hostname unless another order is given.
+func (r *NodeRepository) QueryNodes(
+	filters []*model.NodeFilter,
+	order *model.OrderByInput,
+) ([]*schema.Node, error) {
+	query := sq.Select(nodeColumns...).From("node")
+
+	if order != nil {
+		field := toSnakeCase(order.Field)
+		switch order.Order {
+		case model.SortDirectionEnumAsc:
+			query = query.OrderBy(fmt.Sprintf("node.%s ASC", field))
+		case model.SortDirectionEnumDesc:
+			query = query.OrderBy(fmt.Sprintf("node.%s DESC", field))
+		default:
+			return nil, errors.New("REPOSITORY/NODE > invalid sorting order for column")
+		}
+	} else {
+		query = query.OrderBy("node.cluster ASC", "node.hostname ASC")
+	}
+
+	for _, f := range filters {
+		if f.Hostname != nil {
+			query = buildStringCondition("node.hostname", f.Hostname, query)
+		}
+		if f.Cluster != nil {
+			query = buildStringCondition("node.cluster", f.Cluster, query)
+		}
+		if f.SubCluster != nil {
+			query = buildStringCondition("node.subcluster", f.SubCluster, query)
+		}
+		if f.NodeState != nil {
+			query = query.Where(sq.Eq{"node.node_state": f.NodeState})
+		}
+		if f.HealthState != nil {
+			query = query.Where(sq.Eq{"node.health_state": f.HealthState})
+		}
+		if f.Reason != nil {
+			query = buildStringCondition("node.reason", f.Reason, query)
+		}
+	}
+
+	rows, err := query.RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while running query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	nodes := make([]*schema.Node, 0, 50)
+	for rows.Next() {
+		node, err := scanNode(rows)
+		if err != nil {
+			log.Warn("Error while scanning rows (Nodes)")
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	return nodes, rows.Err()
+}
+
+// CountStates returns the number of nodes per node state of a cluster.
+func (r *NodeRepository) CountStates(cluster string) (map[schema.NodeState]int, error) {
+	rows, err := sq.Select("node_state", "count(*)").From("node").
+		Where("node.cluster = ?", cluster).GroupBy("node_state").
+		RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while counting node states: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[schema.NodeState]int)
+	for rows.Next() {
+		var state schema.NodeState
+		var count int
+		if err := rows.Scan(&state, &count); err != nil {
+			log.Warn("Error while scanning rows")
+			return nil, err
+		}
+		counts[state] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// NodeStateHistory returns the state changes of a node between `from` and `to`
+// (Unix timestamps). The first entry is the state the node was in at `from`,
+// if it is known.
+func (r *NodeRepository) NodeStateHistory(cluster string, hostname string, from int64, to int64) ([]*schema.NodeStateChange, error) {
+	nodeId := sq.Select("id").From("node").
+		Where("node.cluster = ?", cluster).Where("node.hostname = ?", hostname).
+		PlaceholderFormat(sq.Question) // Placeholders are numbered by the outer query
+
+	history := make([]*schema.NodeStateChange, 0)
+	columns := []string{"node_state", "health_state", "reason", "time_stamp"}
+
+	var initial schema.NodeStateChange
+	err := sq.Select(columns...).From("node_state_history").
+		Where(sq.Expr("node_id = (?)", nodeId)).Where("time_stamp <= ?", from).
+		OrderBy("time_stamp DESC").Limit(1).
+		RunWith(r.stmtCache).QueryRow().
+		Scan(&initial.NodeState, &initial.HealthState, &initial.Reason, &initial.TimeStamp)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		log.Warnf("Error while querying state history of node '%s'", hostname)
+		return nil, err
+	default:
+		history = append(history, &initial)
+	}
+
+	rows, err := sq.Select(columns...).From("node_state_history").
+		Where(sq.Expr("node_id = (?)", nodeId)).
+		Where("time_stamp > ?", from).Where("time_stamp <= ?", to).
+		OrderBy("time_stamp ASC").
+		RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while querying state history of node '%s': %v", hostname, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		change := &schema.NodeStateChange{}
+		if err := rows.Scan(&change.NodeState, &change.HealthState, &change.Reason, &change.TimeStamp); err != nil {
+			log.Warn("Error while scanning rows (NodeStateChange)")
+			return nil, err
+		}
+		history = append(history, change)
+	}
+
+	return history, rows.Err()
+}
@@ -6,6 +6,9 @@ package repository
 
 import (
 	"context"
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
@@ -14,6 +17,43 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// Tests run on a copy of testdata/job.db, so that tests writing to the
+// database do not change it.
+var testDB string
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "cc-backend-repository")
+	if err != nil {
+		panic(err)
+	}
+	testDB = filepath.Join(dir, "job.db")
+	if err := copyFile("testdata/job.db", testDB); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
 func TestPragma(t *testing.T) {
 	t.Run("sets up a new DB", func(t *testing.T) {
 		db := setup(t)
@@ -148,10 +188,9 @@ func getContext(tb testing.TB) context.Context {
 func setup(tb testing.TB) *JobRepository {
 	tb.Helper()
 	log.Init("warn", true)
-	dbfile := "testdata/job.db"
-	err := MigrateDB("sqlite3", dbfile)
+	err := MigrateDB("sqlite3", testDB)
 	noErr(tb, err)
-	Connect("sqlite3", dbfile)
+	Connect("sqlite3", testDB)
 	return GetJobRepository()
 }
 
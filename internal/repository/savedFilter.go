@@ -0,0 +1,291 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	savedFilterRepoOnce     sync.Once
+	savedFilterRepoInstance *SavedFilterRepository
+)
+
+// SavedFilterRepository stores named job filters. A saved filter is
+// private to its owner, shared with the managers of a project or shared
+// with all users.
+type SavedFilterRepository struct {
+	DB        *sqlx.DB
+	stmtCache *sq.StmtCache
+	driver    string
+}
+
+func GetSavedFilterRepository() *SavedFilterRepository {
+	savedFilterRepoOnce.Do(func() {
+		db := GetConnection()
+
+		savedFilterRepoInstance = &SavedFilterRepository{
+			DB:     db.DB,
+			driver: db.Driver,
+
+			stmtCache: sq.NewStmtCache(db.DB),
+		}
+	})
+	return savedFilterRepoInstance
+}
+
+var savedFilterColumns []string = []string{
+	"job_filter.id", "job_filter.name", "job_filter.owner", "job_filter.shared",
+	"job_filter.project", "job_filter.filter", "job_filter.created_at", "job_filter.updated_at",
+}
+
+func scanSavedFilter(row interface{ Scan(...interface{}) error }) (*model.SavedJobFilter, error) {
+	f := &model.SavedJobFilter{}
+
+	if err := row.Scan(
+		&f.ID, &f.Name, &f.Owner, &f.Shared,
+		&f.Project, &f.RawFilter, &f.CreatedAt, &f.UpdatedAt); err != nil {
+		if err != sql.ErrNoRows {
+			log.Warnf("Error while scanning rows (SavedJobFilter): %v", err)
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(f.RawFilter, &f.Filter); err != nil {
+		log.Warn("Error while unmarshaling raw filter json")
+		return nil, err
+	}
+	f.RawFilter = nil
+
+	return f, nil
+}
+
+// Restrict the query to the saved filters visible to the user. Admins and
+// support staff can see all shared filters, managers the filters shared
+// with their projects.
+func visibleSavedFilters(user *schema.User, q sq.SelectBuilder) sq.SelectBuilder {
+	if user == nil {
+		return q.Where("job_filter.shared != 'private'")
+	}
+
+	if user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
+		return q.Where(sq.Or{
+			sq.Eq{"job_filter.owner": user.Username},
+			sq.NotEq{"job_filter.shared": "private"},
+		})
+	}
+
+	visible := sq.Or{
+		sq.Eq{"job_filter.owner": user.Username},
+		sq.Eq{"job_filter.shared": "global"},
+	}
+	if user.HasRole(schema.RoleManager) && len(user.Projects) != 0 {
+		visible = append(visible, sq.And{
+			sq.Eq{"job_filter.shared": "project"},
+			sq.Eq{"job_filter.project": user.Projects},
+		})
+	}
+	return q.Where(visible)
+}
+
+func checkSharingAuth(user *schema.User, shared string, project string) error {
+	switch shared {
+	case "private":
+		return nil
+	case "project":
+		if project == "" {
+			return errors.New("a project is required to share a filter with a project")
+		}
+		if user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) ||
+			(user.HasRole(schema.RoleManager) && user.HasProject(project)) {
+			return nil
+		}
+		return fmt.Errorf("cannot share filter with project '%s' with current authorization", project)
+	case "global":
+		if user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
+			return nil
+		}
+		return errors.New("only admins and support staff can share filters globally")
+	default:
+		return fmt.Errorf("invalid sharing of filter: %s", shared)
+	}
+}
+
+// Save stores the filter under the given name for the user. An existing
+// filter of the user with the same name is replaced.
+func (r *SavedFilterRepository) Save(user *schema.User, name string, shared string, project string, filter []*model.JobFilter) (*model.SavedJobFilter, error) {
+	if user == nil {
+		return nil, errors.New("saving a filter requires a user")
+	}
+	if name == "" {
+		return nil, errors.New("a saved filter needs a name")
+	}
+	if shared == "" {
+		shared = "private"
+	}
+	if shared != "project" {
+		project = ""
+	}
+	if err := checkSharingAuth(user, shared, project); err != nil {
+		return nil, err
+	}
+	for _, f := range filter {
+		if f.SavedFilter != nil {
+			return nil, errors.New("a saved filter cannot refer to other saved filters")
+		}
+	}
+
+	rawFilter, err := json.Marshal(filter)
+	if err != nil {
+		log.Warn("Error while marshaling filter")
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	saved, err := scanSavedFilter(sq.Select(savedFilterColumns...).From("job_filter").
+		Where("job_filter.owner = ?", user.Username).Where("job_filter.name = ?", name).
+		RunWith(r.stmtCache).QueryRow())
+	switch {
+	case err == sql.ErrNoRows:
+		saved = &model.SavedJobFilter{Name: name, Owner: user.Username, CreatedAt: now}
+		q := sq.Insert("job_filter").
+			Columns("name", "owner", "shared", "project", "filter", "created_at", "updated_at").
+			Values(name, user.Username, shared, project, string(rawFilter), now, now)
+
+		saved.ID, err = insertReturningId(r.DB, q)
+	case err == nil:
+		_, err = sq.Update("job_filter").
+			Set("shared", shared).
+			Set("project", project).
+			Set("filter", string(rawFilter)).
+			Set("updated_at", now).
+			Where("job_filter.id = ?", saved.ID).
+			RunWith(r.stmtCache).Exec()
+	}
+	if err != nil {
+		log.Errorf("Error while saving job filter '%s': %v", name, err)
+		return nil, err
+	}
+
+	saved.Shared, saved.Project, saved.Filter, saved.UpdatedAt = shared, project, filter, now
+	return saved, nil
+}
+
+// Delete removes a saved filter. Only the owner or an admin can delete it.
+func (r *SavedFilterRepository) Delete(user *schema.User, id int64) error {
+	saved, err := scanSavedFilter(sq.Select(savedFilterColumns...).From("job_filter").
+		Where("job_filter.id = ?", id).RunWith(r.stmtCache).QueryRow())
+	if err != nil {
+		return err
+	}
+	if user == nil || (saved.Owner != user.Username && !user.HasRole(schema.RoleAdmin)) {
+		return errors.New("only the owner or an admin can delete a saved filter")
+	}
+
+	if _, err := sq.Delete("job_filter").Where("job_filter.id = ?", id).
+		RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while deleting job filter: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// List returns all saved filters visible to the user, ordered by name.
+func (r *SavedFilterRepository) List(user *schema.User) ([]*model.SavedJobFilter, error) {
+	return r.query(user, sq.Select(savedFilterColumns...).From("job_filter").
+		OrderBy("job_filter.name ASC", "job_filter.id ASC"))
+}
+
+// Find returns the saved filter with the given name visible to the user.
+// Filters of the user take precedence over filters shared with a project,
+// which take precedence over globally shared filters.
+func (r *SavedFilterRepository) Find(user *schema.User, name string) (*model.SavedJobFilter, error) {
+	filters, err := r.query(user, sq.Select(savedFilterColumns...).From("job_filter").
+		Where("job_filter.name = ?", name))
+	if err != nil {
+		return nil, err
+	}
+
+	var found *model.SavedJobFilter
+	rank := func(f *model.SavedJobFilter) int {
+		switch {
+		case user != nil && f.Owner == user.Username:
+			return 0
+		case f.Shared == "project":
+			return 1
+		default:
+			return 2
+		}
+	}
+	for _, f := range filters {
+		if found == nil || rank(f) < rank(found) {
+			found = f
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no saved filter named '%s'", name)
+	}
+
+	return found, nil
+}
+
+func (r *SavedFilterRepository) query(user *schema.User, q sq.SelectBuilder) ([]*model.SavedJobFilter, error) {
+	q = visibleSavedFilters(user, q)
+
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		queryString, queryVars, _ := q.ToSql()
+		log.Errorf("Error while running query '%s' %v: %v", queryString, queryVars, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	filters := make([]*model.SavedJobFilter, 0)
+	for rows.Next() {
+		f, err := scanSavedFilter(rows)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+
+	return filters, rows.Err()
+}
+
+// Expand replaces references to saved filters by the filters stored under
+// that name. All filters of the list have to match.
+func (r *SavedFilterRepository) Expand(user *schema.User, filter []*model.JobFilter) ([]*model.JobFilter, error) {
+	expanded := make([]*model.JobFilter, 0, len(filter))
+	for _, f := range filter {
+		if f.SavedFilter == nil {
+			expanded = append(expanded, f)
+			continue
+		}
+
+		saved, err := r.Find(user, *f.SavedFilter)
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, saved.Filter...)
+
+		rest := *f
+		rest.SavedFilter = nil
+		expanded = append(expanded, &rest)
+	}
+
+	return expanded, nil
+}
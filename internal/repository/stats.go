@@ -21,9 +21,10 @@ import (
 
 // GraphQL validation should make sure that no unkown values can be specified.
 var groupBy2column = map[model.Aggregate]string{
-	model.AggregateUser:    "job.hpc_user",
-	model.AggregateProject: "job.project",
-	model.AggregateCluster: "job.cluster",
+	model.AggregateUser:      "job.hpc_user",
+	model.AggregateProject:   "job.project",
+	model.AggregateCluster:   "job.cluster",
+	model.AggregatePartition: "job.cluster_partition",
 }
 
 var sortBy2column = map[model.SortByAggregate]string{
@@ -67,35 +68,42 @@ func (r *JobRepository) buildCountQuery(
 }
 
 func (r *JobRepository) buildStatsQuery(
+	ctx context.Context,
 	filter []*model.JobFilter,
 	col string,
 ) sq.SelectBuilder {
+	// The rollup does not distinguish partitions
+	if col != "job.cluster_partition" && usageRollupApplies(filter) &&
+		!r.sharesCollectionsWith(GetUserFromContext(ctx)) {
+		return r.buildRollupStatsQuery(filter, col)
+	}
+
 	var query sq.SelectBuilder
 	castType := r.getCastType()
 
-	// fmt.Sprintf(`CAST(ROUND((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END) / 3600) as %s) as value`, time.Now().Unix(), castType)
+	// fmt.Sprintf(`CAST(ROUND((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END) / 3600) as %s) as value`, time.Now().Unix(), castType)
 
 	if col != "" {
 		// Scan columns: id, totalJobs, name, totalWalltime, totalNodes, totalNodeHours, totalCores, totalCoreHours, totalAccs, totalAccHours
-		query = sq.Select(col, "COUNT(job.id) as totalJobs", "name",
-			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END)) / 3600) as %s) as totalWalltime`, time.Now().Unix(), castType),
+		query = sq.Select(col, "COUNT(job.id) as totalJobs", "MAX(hpc_user.name) as name",
+			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END)) / 3600) as %s) as totalWalltime`, time.Now().Unix(), castType),
 			fmt.Sprintf(`CAST(SUM(job.num_nodes) as %s) as totalNodes`, castType),
-			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END) * job.num_nodes) / 3600) as %s) as totalNodeHours`, time.Now().Unix(), castType),
+			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END) * job.num_nodes) / 3600) as %s) as totalNodeHours`, time.Now().Unix(), castType),
 			fmt.Sprintf(`CAST(SUM(job.num_hwthreads) as %s) as totalCores`, castType),
-			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END) * job.num_hwthreads) / 3600) as %s) as totalCoreHours`, time.Now().Unix(), castType),
+			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END) * job.num_hwthreads) / 3600) as %s) as totalCoreHours`, time.Now().Unix(), castType),
 			fmt.Sprintf(`CAST(SUM(job.num_acc) as %s) as totalAccs`, castType),
-			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END) * job.num_acc) / 3600) as %s) as totalAccHours`, time.Now().Unix(), castType),
+			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END) * job.num_acc) / 3600) as %s) as totalAccHours`, time.Now().Unix(), castType),
 		).From("job").LeftJoin("hpc_user ON hpc_user.username = job.hpc_user").GroupBy(col)
 	} else {
 		// Scan columns: totalJobs, name, totalWalltime, totalNodes, totalNodeHours, totalCores, totalCoreHours, totalAccs, totalAccHours
 		query = sq.Select("COUNT(job.id)",
-			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END)) / 3600) as %s)`, time.Now().Unix(), castType),
+			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END)) / 3600) as %s)`, time.Now().Unix(), castType),
 			fmt.Sprintf(`CAST(SUM(job.num_nodes) as %s)`, castType),
-			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END) * job.num_nodes) / 3600) as %s)`, time.Now().Unix(), castType),
+			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END) * job.num_nodes) / 3600) as %s)`, time.Now().Unix(), castType),
 			fmt.Sprintf(`CAST(SUM(job.num_hwthreads) as %s)`, castType),
-			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END) * job.num_hwthreads) / 3600) as %s)`, time.Now().Unix(), castType),
+			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END) * job.num_hwthreads) / 3600) as %s)`, time.Now().Unix(), castType),
 			fmt.Sprintf(`CAST(SUM(job.num_acc) as %s)`, castType),
-			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END) * job.num_acc) / 3600) as %s)`, time.Now().Unix(), castType),
+			fmt.Sprintf(`CAST(ROUND(SUM((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END) * job.num_acc) / 3600) as %s)`, time.Now().Unix(), castType),
 		).From("job")
 	}
 
@@ -124,6 +132,8 @@ func (r *JobRepository) getCastType() string {
 		castType = "int"
 	case "mysql":
 		castType = "unsigned"
+	case "postgres":
+		castType = "bigint"
 	default:
 		castType = ""
 	}
@@ -131,6 +141,15 @@ func (r *JobRepository) getCastType() string {
 	return castType
 }
 
+// Cast expr to an integer, truncating towards zero. Postgres would round.
+func (r *JobRepository) castTruncInt(expr string) string {
+	if r.driver == "postgres" {
+		return fmt.Sprintf(`CAST( TRUNC(%s) as INTEGER )`, expr)
+	}
+
+	return fmt.Sprintf(`CAST( %s as INTEGER )`, expr)
+}
+
 func (r *JobRepository) JobsStatsGrouped(
 	ctx context.Context,
 	filter []*model.JobFilter,
@@ -140,7 +159,7 @@ func (r *JobRepository) JobsStatsGrouped(
 ) ([]*model.JobsStatistics, error) {
 	start := time.Now()
 	col := groupBy2column[*groupBy]
-	query := r.buildStatsQuery(filter, col)
+	query := r.buildStatsQuery(ctx, filter, col)
 
 	query, err := SecurityCheck(ctx, query)
 	if err != nil {
@@ -250,7 +269,7 @@ func (r *JobRepository) JobsStats(
 	filter []*model.JobFilter,
 ) ([]*model.JobsStatistics, error) {
 	start := time.Now()
-	query := r.buildStatsQuery(filter, "")
+	query := r.buildStatsQuery(ctx, filter, "")
 	query, err := SecurityCheck(ctx, query)
 	if err != nil {
 		return nil, err
@@ -442,41 +461,185 @@ func (r *JobRepository) AddJobCount(
 	return stats, nil
 }
 
-func (r *JobRepository) AddHistograms(
+// Statistics by the value of the column grouped by, or the only statistics
+// if not grouped.
+func statsByGroup(stats []*model.JobsStatistics, col string) map[string]*model.JobsStatistics {
+	groups := make(map[string]*model.JobsStatistics, len(stats))
+	for _, s := range stats {
+		if col == "" {
+			groups[""] = s
+			break
+		}
+		groups[s.ID] = s
+	}
+	return groups
+}
+
+// AddWaitTimes adds the average and the maximum time jobs waited in the
+// queue to the statistics, per group if `groupBy` is set. Only jobs with a
+// known submit time are considered.
+func (r *JobRepository) AddWaitTimes(
 	ctx context.Context,
 	filter []*model.JobFilter,
-	stat *model.JobsStatistics,
-	durationBins *string,
-) (*model.JobsStatistics, error) {
+	groupBy *model.Aggregate,
+	stats []*model.JobsStatistics,
+) ([]*model.JobsStatistics, error) {
+	start := time.Now()
+	columns := []string{
+		fmt.Sprintf(`CAST(ROUND(AVG(job.start_time - job.submit_time)) as %s)`, r.getCastType()),
+		`MAX(job.start_time - job.submit_time)`,
+	}
+	col := ""
+	if groupBy != nil {
+		col = groupBy2column[*groupBy]
+		columns = append([]string{col}, columns...)
+	}
+
+	query, err := SecurityCheck(ctx, sq.Select(columns...).From("job").Where("job.submit_time > 0"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range filter {
+		query = BuildWhereClause(f, query)
+	}
+	if col != "" {
+		query = query.GroupBy(col)
+	}
+
+	rows, err := query.RunWith(r.DB).Query()
+	if err != nil {
+		log.Warn("Error while querying DB for job wait times")
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := statsByGroup(stats, col)
+	for rows.Next() {
+		var id sql.NullString
+		var avg, max sql.NullInt64
+		dest := []interface{}{&avg, &max}
+		if col != "" {
+			dest = append([]interface{}{&id}, dest...)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			log.Warn("Error while scanning rows")
+			return nil, err
+		}
+
+		if s, ok := groups[id.String]; ok {
+			s.AvgWaitTime = int(avg.Int64)
+			s.MaxWaitTime = int(max.Int64)
+		}
+	}
+
+	log.Debugf("Timer AddWaitTimes %s", time.Since(start))
+	return stats, rows.Err()
+}
+
+// AddWaitTimeHistograms adds histograms of the time jobs waited in the queue
+// to the statistics, per group if `groupBy` is set. Only jobs with a known
+// submit time are considered.
+func (r *JobRepository) AddWaitTimeHistograms(
+	ctx context.Context,
+	filter []*model.JobFilter,
+	groupBy *model.Aggregate,
+	stats []*model.JobsStatistics,
+	waitTimeBins *string,
+) ([]*model.JobsStatistics, error) {
 	start := time.Now()
+	targetBinCount, targetBinSize := durationBinning(*waitTimeBins)
+
+	// Return X-Values always as seconds, like the duration histogram
+	columns := []string{
+		fmt.Sprintf(`CAST(ROUND(((job.start_time - job.submit_time) / %d) + 1) as %s) as value`, targetBinSize, r.getCastType()),
+		"COUNT(job.id) AS count",
+	}
+	groupCols := []string{"value"}
+	col := ""
+	if groupBy != nil {
+		col = groupBy2column[*groupBy]
+		columns = append([]string{col}, columns...)
+		groupCols = append([]string{col}, groupCols...)
+	}
+
+	query, err := SecurityCheck(ctx, sq.Select(columns...).From("job").Where("job.submit_time > 0"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range filter {
+		query = BuildWhereClause(f, query)
+	}
+
+	rows, err := query.GroupBy(groupCols...).RunWith(r.DB).Query()
+	if err != nil {
+		log.Error("Error while running query")
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := statsByGroup(stats, col)
+	for _, s := range groups {
+		s.HistWaitTime = make([]*model.HistoPoint, 0, targetBinCount)
+		for i := 1; i <= targetBinCount; i++ {
+			s.HistWaitTime = append(s.HistWaitTime, &model.HistoPoint{Value: i * targetBinSize, Count: 0})
+		}
+	}
+
+	for rows.Next() {
+		var id sql.NullString
+		var value, count int
+		dest := []interface{}{&value, &count}
+		if col != "" {
+			dest = append([]interface{}{&id}, dest...)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			log.Warn("Error while scanning rows")
+			return nil, err
+		}
+
+		// Wait times beyond the last bin are not shown
+		if s, ok := groups[id.String]; ok && value >= 1 && value <= targetBinCount {
+			s.HistWaitTime[value-1].Count = count
+		}
+	}
 
-	var targetBinCount int
-	var targetBinSize int
+	log.Debugf("Timer AddWaitTimeHistograms %s", time.Since(start))
+	return stats, rows.Err()
+}
+
+// Number and size in seconds of the bins of histograms over durations
+func durationBinning(bins string) (targetBinCount int, targetBinSize int) {
 	switch {
-	case *durationBins == "1m": // 1 Minute Bins + Max 60 Bins -> Max 60 Minutes
-		targetBinCount = 60
-		targetBinSize = 60
-	case *durationBins == "10m": // 10 Minute Bins + Max 72 Bins -> Max 12 Hours
-		targetBinCount = 72
-		targetBinSize = 600
-	case *durationBins == "1h": // 1 Hour Bins + Max 48 Bins -> Max 48 Hours
-		targetBinCount = 48
-		targetBinSize = 3600
-	case *durationBins == "6h": // 6 Hour Bins + Max 12 Bins -> Max 3 Days
-		targetBinCount = 12
-		targetBinSize = 21600
-	case *durationBins == "12h": // 12 hour Bins + Max 14 Bins -> Max 7 Days
-		targetBinCount = 14
-		targetBinSize = 43200
+	case bins == "1m": // 1 Minute Bins + Max 60 Bins -> Max 60 Minutes
+		return 60, 60
+	case bins == "10m": // 10 Minute Bins + Max 72 Bins -> Max 12 Hours
+		return 72, 600
+	case bins == "1h": // 1 Hour Bins + Max 48 Bins -> Max 48 Hours
+		return 48, 3600
+	case bins == "6h": // 6 Hour Bins + Max 12 Bins -> Max 3 Days
+		return 12, 21600
+	case bins == "12h": // 12 hour Bins + Max 14 Bins -> Max 7 Days
+		return 14, 43200
 	default: // 24h
-		targetBinCount = 24
-		targetBinSize = 3600
+		return 24, 3600
 	}
+}
+
+// The histograms count jobs by values of single jobs, which the usage rollup
+// does not keep. They are always computed from the job table.
+func (r *JobRepository) AddHistograms(
+	ctx context.Context,
+	filter []*model.JobFilter,
+	stat *model.JobsStatistics,
+	durationBins *string,
+) (*model.JobsStatistics, error) {
+	start := time.Now()
+	targetBinCount, targetBinSize := durationBinning(*durationBins)
 
 	castType := r.getCastType()
 	var err error
 	// Return X-Values always as seconds, will be formatted into minutes and hours in frontend
-	value := fmt.Sprintf(`CAST(ROUND(((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END) / %d) + 1) as %s) as value`, time.Now().Unix(), targetBinSize, castType)
+	value := fmt.Sprintf(`CAST(ROUND(((CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END) / %d) + 1) as %s) as value`, time.Now().Unix(), targetBinSize, castType)
 	stat.HistDuration, err = r.jobsDurationStatisticsHistogram(ctx, value, filter, targetBinSize, &targetBinCount)
 	if err != nil {
 		log.Warn("Error while loading job statistics histogram: job duration")
@@ -678,13 +841,13 @@ func (r *JobRepository) jobsMetricStatisticsHistogram(
 	// Make bins, see https://jereze.com/code/sql-histogram/
 
 	start := time.Now()
-	jm := fmt.Sprintf(`json_extract(footprint, "$.%s")`, (metric + "_" + footprintStat))
+	jm := jsonNumber("footprint", metric+"_"+footprintStat)
 
 	crossJoinQuery := sq.Select(
 		fmt.Sprintf(`max(%s) as max`, jm),
 		fmt.Sprintf(`min(%s) as min`, jm),
 	).From("job").Where(
-		"JSON_VALID(footprint)",
+		jsonValid("footprint"),
 	).Where(
 		fmt.Sprintf(`%s is not null`, jm),
 	).Where(
@@ -701,20 +864,23 @@ func (r *JobRepository) jobsMetricStatisticsHistogram(
 		crossJoinQuery = BuildWhereClause(f, crossJoinQuery)
 	}
 
-	crossJoinQuerySql, crossJoinQueryArgs, sqlerr := crossJoinQuery.ToSql()
+	// Placeholders are numbered when the main query is built
+	crossJoinQuerySql, crossJoinQueryArgs, sqlerr := crossJoinQuery.PlaceholderFormat(sq.Question).ToSql()
 	if sqlerr != nil {
 		return nil, sqlerr
 	}
 
-	binQuery := fmt.Sprintf(`CAST( (case when %s = value.max
+	binQuery := r.castTruncInt(fmt.Sprintf(`(case when %s = value.max
 	   then value.max*0.999999999 else %s end - value.min) / (value.max -
-	   value.min) * %v as INTEGER )`, jm, jm, *bins)
+	   value.min) * %v`, jm, jm, *bins))
 
+	// Bin bounds are the same for all rows of a bin, the aggregate is only
+	// required to satisfy strict GROUP BY checks.
 	mainQuery := sq.Select(
 		fmt.Sprintf(`%s + 1 as bin`, binQuery),
 		fmt.Sprintf(`count(%s) as count`, jm),
-		fmt.Sprintf(`CAST(((value.max / %d) * (%v     )) as INTEGER ) as min`, *bins, binQuery),
-		fmt.Sprintf(`CAST(((value.max / %d) * (%v + 1 )) as INTEGER ) as max`, *bins, binQuery),
+		fmt.Sprintf(`MIN(%s) as min`, r.castTruncInt(fmt.Sprintf(`(value.max / %d) * (%v     )`, *bins, binQuery))),
+		fmt.Sprintf(`MIN(%s) as max`, r.castTruncInt(fmt.Sprintf(`(value.max / %d) * (%v + 1 )`, *bins, binQuery))),
 	).From("job").CrossJoin(
 		fmt.Sprintf(`(%s) as value`, crossJoinQuerySql), crossJoinQueryArgs...,
 	).Where(fmt.Sprintf(`%s is not null and %s <= %f`, jm, jm, peak))
@@ -5,15 +5,20 @@
 package repository
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/ClusterCockpit/cc-backend/internal/config"
 	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 )
 
 func TestBuildJobStatsQuery(t *testing.T) {
 	r := setup(t)
-	q := r.buildStatsQuery(nil, "USER")
+	q := r.buildStatsQuery(getContext(t), nil, "USER")
 
 	sql, _, err := q.ToSql()
 	noErr(t, err)
@@ -33,3 +38,146 @@ func TestJobStats(t *testing.T) {
 		t.Fatalf("Want 98, Got %d", stats[0].TotalJobs)
 	}
 }
+
+// Build the usage rollup for a test and remove it afterwards.
+func enableUsageRollup(t *testing.T, r *JobRepository) {
+	t.Helper()
+	config.Keys.EnableUsageRollup = true
+	t.Cleanup(func() {
+		config.Keys.EnableUsageRollup = false
+		usageRollupCutoff.Store(0)
+		if _, err := r.DB.Exec("DELETE FROM job_usage_daily"); err != nil {
+			t.Error(err)
+		}
+	})
+	noErr(t, r.UpdateUsageRollup())
+}
+
+func TestJobStatsUsageRollupFallback(t *testing.T) {
+	r := setup(t)
+	_, err := r.DB.Exec("UPDATE job SET submit_time = start_time - 60")
+	noErr(t, err)
+	t.Cleanup(func() { r.DB.Exec("UPDATE job SET submit_time = 0") })
+	enableUsageRollup(t, r)
+
+	from, to := time.Unix(0, 0), time.Now()
+	for name, filter := range map[string]*model.JobFilter{
+		"submitTime": {SubmitTime: &schema.TimeRange{From: &from, To: &to}},
+		"waitTime":   {WaitTime: &schema.IntRange{From: 0, To: 1000000000}},
+	} {
+		if usageRollupApplies([]*model.JobFilter{filter}) {
+			t.Errorf("%s: expected the rollup not to apply", name)
+		}
+		stats, err := r.JobsStats(getContext(t), []*model.JobFilter{filter})
+		noErr(t, err)
+		if stats[0].TotalJobs != 6 {
+			t.Errorf("%s: want 6 jobs, got %d", name, stats[0].TotalJobs)
+		}
+	}
+}
+
+func TestJobStatsUsageRollup(t *testing.T) {
+	r := setup(t)
+	want, err := r.JobsStats(getContext(t), nil)
+	noErr(t, err)
+	groupBy := model.AggregateUser
+	wantGrouped, err := r.JobsStatsGrouped(getContext(t), nil, nil, nil, &groupBy)
+	noErr(t, err)
+
+	enableUsageRollup(t, r)
+	if !usageRollupApplies(nil) {
+		t.Fatal("expected the rollup to apply")
+	}
+
+	got, err := r.JobsStats(getContext(t), nil)
+	noErr(t, err)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want[0], got[0])
+	}
+
+	gotGrouped, err := r.JobsStatsGrouped(getContext(t), nil, nil, nil, &groupBy)
+	noErr(t, err)
+	if len(gotGrouped) != len(wantGrouped) {
+		t.Fatalf("want %d users, got %d", len(wantGrouped), len(gotGrouped))
+	}
+	for i := range wantGrouped {
+		if gotGrouped[i].ID != wantGrouped[i].ID || gotGrouped[i].TotalJobs != wantGrouped[i].TotalJobs ||
+			gotGrouped[i].TotalCoreHours != wantGrouped[i].TotalCoreHours {
+			t.Errorf("want %#v, got %#v", wantGrouped[i], gotGrouped[i])
+		}
+	}
+}
+
+func TestJobStatsUsageRollupLateJobs(t *testing.T) {
+	r := setup(t)
+	enableUsageRollup(t, r)
+
+	var ids []int64
+	t.Cleanup(func() {
+		for _, id := range ids {
+			r.DB.Exec("DELETE FROM job_event WHERE job_id = ?", id)
+			r.DB.Exec("DELETE FROM job WHERE id = ?", id)
+		}
+	})
+	newJob := func(jobId int64, state schema.JobState, startTime int64, duration int32) *schema.JobMeta {
+		return &schema.JobMeta{BaseJob: schema.BaseJob{
+			JobID: jobId, User: "late", Project: "late", Cluster: "fritz", SubCluster: "main",
+			NumNodes: 1, NumHWThreads: 72, State: state, Duration: duration,
+			Resources: []*schema.Resource{{Hostname: "f0101"}},
+		}, StartTime: startTime}
+	}
+	twoDaysAgo := time.Now().Unix() - 2*secondsPerDay
+
+	// An imported job that ended before the last rollup update
+	id, err := r.Start(nil, newJob(1, schema.JobStateCompleted, twoDaysAgo, 3600))
+	noErr(t, err)
+	ids = append(ids, id)
+
+	// A job stopped more than a day late
+	id, err = r.Start(nil, newJob(2, schema.JobStateRunning, twoDaysAgo, 0))
+	noErr(t, err)
+	ids = append(ids, id)
+	noErr(t, r.Stop(nil, id, 3600, schema.JobStateCompleted, schema.MonitoringStatusArchivingSuccessful))
+
+	// The same within a transaction
+	tx, err := r.TransactionInit()
+	noErr(t, err)
+	id, err = r.TransactionStart(tx, nil, newJob(3, schema.JobStateRunning, twoDaysAgo, 0))
+	noErr(t, err)
+	ids = append(ids, id)
+	noErr(t, r.TransactionStop(tx, nil, id, 3600, schema.JobStateCompleted, schema.MonitoringStatusArchivingSuccessful))
+	noErr(t, r.TransactionEnd(tx))
+
+	user := "late"
+	stats, err := r.JobsStats(getContext(t), []*model.JobFilter{{User: &model.StringInput{Eq: &user}}})
+	noErr(t, err)
+	if stats[0].TotalJobs != 3 || stats[0].TotalWalltime != 3 {
+		t.Errorf("want 3 jobs with 3 hours, got %d jobs with %d hours", stats[0].TotalJobs, stats[0].TotalWalltime)
+	}
+}
+
+func TestJobStatsUsageRollupSharedCollection(t *testing.T) {
+	r := setup(t)
+	admin := GetUserFromContext(getContext(t))
+	collections := GetCollectionRepository()
+	c, err := collections.Create(admin, "rollup", "")
+	noErr(t, err)
+	t.Cleanup(func() { collections.Delete(admin, c.ID) })
+	_, err = collections.AddJobs(getContext(t), c.ID, []int64{4})
+	noErr(t, err)
+	_, err = collections.Share(admin, c.ID, []string{"mppi067h"}, nil)
+	noErr(t, err)
+
+	user := &schema.User{Username: "mppi067h", Roles: []string{schema.GetRoleString(schema.RoleUser)}}
+	ctx := context.WithValue(context.Background(), ContextUserKey, user)
+	enableUsageRollup(t, r)
+	if !r.sharesCollectionsWith(user) {
+		t.Fatal("expected a collection shared with the user")
+	}
+
+	stats, err := r.JobsStats(ctx, nil)
+	noErr(t, err)
+	if stats[0].TotalJobs != 4 {
+		t.Errorf("want 4 jobs, got %d", stats[0].TotalJobs)
+	}
+}
@@ -6,7 +6,6 @@ package repository
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/ClusterCockpit/cc-backend/pkg/archive"
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
@@ -36,12 +35,13 @@ func (r *JobRepository) AddTag(user *schema.User, job int64, tag int64) ([]*sche
 		return nil, err
 	}
 
-	archiveTags, err := r.getArchiveTags(&job)
+	archiveTags, err := r.GetArchiveTags(&job)
 	if err != nil {
 		log.Warn("Error while getting tags for job")
 		return nil, err
 	}
 
+	r.AddJobEvent(job, schema.JobEventTag, "", user, r.tagDetails(tag))
 	return tags, archive.UpdateTags(j, archiveTags)
 }
 
@@ -67,12 +67,13 @@ func (r *JobRepository) RemoveTag(user *schema.User, job, tag int64) ([]*schema.
 		return nil, err
 	}
 
-	archiveTags, err := r.getArchiveTags(&job)
+	archiveTags, err := r.GetArchiveTags(&job)
 	if err != nil {
 		log.Warn("Error while getting tags for job")
 		return nil, err
 	}
 
+	r.AddJobEvent(job, schema.JobEventUntag, "", user, r.tagDetails(tag))
 	return tags, archive.UpdateTags(j, archiveTags)
 }
 
@@ -85,14 +86,13 @@ func (r *JobRepository) CreateTag(tagType string, tagName string, tagScope strin
 
 	q := sq.Insert("tag").Columns("tag_type", "tag_name", "tag_scope").Values(tagType, tagName, tagScope)
 
-	res, err := q.RunWith(r.stmtCache).Exec()
-	if err != nil {
+	if tagId, err = insertReturningId(r.DB, q); err != nil {
 		s, _, _ := q.ToSql()
 		log.Errorf("Error inserting tag with %s: %v", s, err)
 		return 0, err
 	}
 
-	return res.LastInsertId()
+	return tagId, nil
 }
 
 func (r *JobRepository) CountTags(user *schema.User) (tags []schema.Tag, counts map[string]int, err error) {
@@ -123,25 +123,27 @@ func (r *JobRepository) CountTags(user *schema.User) (tags []schema.Tag, counts
 	q := sq.Select("t.tag_name, t.id, count(jt.tag_id)").
 		From("tag t").
 		LeftJoin("jobtag jt ON t.id = jt.tag_id").
-		GroupBy("t.tag_name")
+		GroupBy("t.tag_name", "t.id")
 
 	// Handle Scope Filtering
-	scopeList := "\"global\""
+	scopeList := []string{"global"}
 	if user != nil {
-		scopeList += ",\"" + user.Username + "\""
+		scopeList = append(scopeList, user.Username)
 	}
 	if user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) {
-		scopeList += ",\"admin\""
+		scopeList = append(scopeList, "admin")
 	}
-	q = q.Where("t.tag_scope IN (" + scopeList + ")")
+	q = q.Where(sq.Eq{"t.tag_scope": scopeList})
 
 	// Handle Job Ownership
 	if user != nil && user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) { // ADMIN || SUPPORT: Count all jobs
 		// log.Debug("CountTags: User Admin or Support -> Count all Jobs for Tags")
 		// Unchanged: Needs to be own case still, due to UserRole/NoRole compatibility handling in else case
 	} else if user != nil && user.HasRole(schema.RoleManager) { // MANAGER: Count own jobs plus project's jobs
-		// Build ("project1", "project2", ...) list of variable length directly in SQL string
-		q = q.Where("jt.job_id IN (SELECT id FROM job WHERE job.hpc_user = ? OR job.project IN (\""+strings.Join(user.Projects, "\",\"")+"\"))", user.Username)
+		q = q.Where(sq.Expr("jt.job_id IN (?)", sq.Select("id").From("job").Where(sq.Or{
+			sq.Eq{"job.hpc_user": user.Username},
+			sq.Eq{"job.project": user.Projects},
+		}).PlaceholderFormat(sq.Question))) // Placeholders are numbered by the outer query
 	} else if user != nil { // USER OR NO ROLE (Compatibility): Only count own jobs
 		q = q.Where("jt.job_id IN (SELECT id FROM job WHERE job.hpc_user = ?)", user.Username)
 	}
@@ -198,6 +200,55 @@ func (r *JobRepository) AddTagOrCreate(user *schema.User, jobId int64, tagType s
 	return tagId, nil
 }
 
+// AddTagOrCreateDirect adds the global tag with the given type and name to a
+// job without checking the scope. Used by background tasks without user context.
+// Once the tag is stored, failures to update the archive are only logged, so
+// that the caller does not retry adding the tag. Returns the tagged job and
+// the tag.
+func (r *JobRepository) AddTagOrCreateDirect(jobId int64, tagType string, tagName string) (*schema.Job, *schema.Tag, error) {
+	j, err := r.FindByIdDirect(jobId)
+	if err != nil {
+		log.Warn("Error while finding job by id")
+		return nil, nil, err
+	}
+
+	tagId, exists := r.TagId(tagType, tagName, "global")
+	if !exists {
+		tagId, err = r.CreateTag(tagType, tagName, "global")
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	q := sq.Insert("jobtag").Columns("job_id", "tag_id").Values(jobId, tagId)
+
+	if _, err := q.RunWith(r.stmtCache).Exec(); err != nil {
+		s, _, _ := q.ToSql()
+		log.Errorf("Error adding tag with %s: %v", s, err)
+		return nil, nil, err
+	}
+
+	r.AddJobEvent(jobId, schema.JobEventTag, "", nil, r.tagDetails(tagId))
+
+	archiveTags, err := r.GetArchiveTags(&jobId)
+	if err == nil {
+		err = archive.UpdateTags(j, archiveTags)
+	}
+	if err != nil {
+		log.Warnf("Error while updating the archived tags of job %d: %v", jobId, err)
+	}
+	return j, &schema.Tag{ID: tagId, Type: tagType, Name: tagName, Scope: "global"}, nil
+}
+
+func (r *JobRepository) tagDetails(tagId int64) string {
+	var tagType, tagName string
+	if err := sq.Select("tag_type", "tag_name").From("tag").Where("tag.id = ?", tagId).
+		RunWith(r.stmtCache).QueryRow().Scan(&tagType, &tagName); err != nil {
+		return fmt.Sprint(tagId)
+	}
+	return fmt.Sprintf("%s:%s", tagType, tagName)
+}
+
 // TagId returns the database id of the tag with the specified type and name.
 func (r *JobRepository) TagId(tagType string, tagName string, tagScope string) (tagId int64, exists bool) {
 	exists = true
@@ -244,7 +295,7 @@ func (r *JobRepository) GetTags(user *schema.User, job *int64) ([]*schema.Tag, e
 }
 
 // GetArchiveTags returns a list of all tags *regardless of scope* for archiving if job is nil or of the tags that the job with that database ID has.
-func (r *JobRepository) getArchiveTags(job *int64) ([]*schema.Tag, error) {
+func (r *JobRepository) GetArchiveTags(job *int64) ([]*schema.Tag, error) {
 	q := sq.Select("id", "tag_type", "tag_name", "tag_scope").From("tag")
 	if job != nil {
 		q = q.Join("jobtag ON jobtag.tag_id = tag.id").Where("jobtag.job_id = ?", *job)
@@ -273,6 +324,11 @@ func (r *JobRepository) getArchiveTags(job *int64) ([]*schema.Tag, error) {
 func (r *JobRepository) ImportTag(jobId int64, tagType string, tagName string, tagScope string) (err error) {
 	// Import has no scope ctx, only import from metafile to DB (No recursive archive update required), only returns err
 
+	// Default to "Global" scope if none defined, as in CreateTag
+	if tagScope == "" {
+		tagScope = "global"
+	}
+
 	tagId, exists := r.TagId(tagType, tagName, tagScope)
 	if !exists {
 		tagId, err = r.CreateTag(tagType, tagName, tagScope)
@@ -12,6 +12,10 @@ import (
 type Transaction struct {
 	tx   *sqlx.Tx
 	stmt *sqlx.NamedStmt
+
+	// Start times of jobs missing in the usage rollup, their days are
+	// aggregated again after the commit
+	usageRollupStartTimes []int64
 }
 
 func (r *JobRepository) TransactionInit() (*Transaction, error) {
@@ -33,6 +37,8 @@ func (r *JobRepository) TransactionCommit(t *Transaction) error {
 			log.Warn("Error while committing transactions")
 			return err
 		}
+		r.refreshUsageRollupOfJobs(t.usageRollupStartTimes...)
+		t.usageRollupStartTimes = nil
 	}
 
 	t.tx, err = r.DB.Beginx()
@@ -49,6 +55,16 @@ func (r *JobRepository) TransactionEnd(t *Transaction) error {
 		log.Warn("Error while committing SQL transactions")
 		return err
 	}
+	r.refreshUsageRollupOfJobs(t.usageRollupStartTimes...)
+	return nil
+}
+
+// TransactionRollback discards all changes of the transaction t.
+func (r *JobRepository) TransactionRollback(t *Transaction) error {
+	if err := t.tx.Rollback(); err != nil {
+		log.Warn("Error while rolling back SQL transactions")
+		return err
+	}
 	return nil
 }
 
@@ -57,6 +73,27 @@ func (r *JobRepository) TransactionAddNamed(
 	query string,
 	args ...interface{},
 ) (int64, error) {
+	if r.driver == "postgres" {
+		var id int64
+		rows, err := t.tx.NamedQuery(returningId(query), args)
+		if err != nil {
+			log.Errorf("Named Query failed: %v", err)
+			return 0, err
+		}
+		defer rows.Close()
+
+		if rows.Next() {
+			err = rows.Scan(&id)
+		} else {
+			err = rows.Err()
+		}
+		if err != nil {
+			log.Errorf("repository initDB(): %v", err)
+			return 0, err
+		}
+		return id, nil
+	}
+
 	res, err := t.tx.NamedExec(query, args)
 	if err != nil {
 		log.Errorf("Named Exec failed: %v", err)
@@ -72,14 +109,21 @@ func (r *JobRepository) TransactionAddNamed(
 	return id, nil
 }
 
+// Execute query within the transaction t. Returns the id of the last inserted
+// row, which is always 0 for postgres. Use TransactionInsert if the id is
+// needed.
 func (r *JobRepository) TransactionAdd(t *Transaction, query string, args ...interface{}) (int64, error) {
 
-	res, err := t.tx.Exec(query, args...)
+	res, err := t.tx.Exec(t.tx.Rebind(query), args...)
 	if err != nil {
 		log.Errorf("TransactionAdd(), Exec() Error: %v", err)
 		return 0, err
 	}
 
+	if r.driver == "postgres" {
+		return 0, nil
+	}
+
 	id, err := res.LastInsertId()
 	if err != nil {
 		log.Errorf("TransactionAdd(), LastInsertId() Error: %v", err)
@@ -88,3 +132,19 @@ func (r *JobRepository) TransactionAdd(t *Transaction, query string, args ...int
 
 	return id, nil
 }
+
+// Insert a row into a table with an id column within the transaction t and
+// return the id of the new row.
+func (r *JobRepository) TransactionInsert(t *Transaction, query string, args ...interface{}) (int64, error) {
+	if r.driver != "postgres" {
+		return r.TransactionAdd(t, query, args...)
+	}
+
+	var id int64
+	if err := t.tx.QueryRow(t.tx.Rebind(returningId(query)), args...).Scan(&id); err != nil {
+		log.Errorf("TransactionInsert(), QueryRow() Error: %v", err)
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,337 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// The job_usage_daily table aggregates all jobs stopped before a cutoff per
+// day (UTC) of the start time, cluster, user and project. Its columns are
+// named as in the job table, so that job filters on these columns and the
+// security checks apply to it as well. The statistics queries combine it
+// with the jobs running or stopped after the cutoff.
+
+const secondsPerDay = 24 * 60 * 60
+
+// Days of jobs stopped up to this long before the last update are aggregated
+// again, to include jobs reported late.
+const usageRollupMargin = 24 * 60 * 60
+
+// Jobs stopped before this Unix timestamp are included in the rollup, zero if
+// the rollup was not updated by this process yet.
+var usageRollupCutoff atomic.Int64
+
+// UpdateUsageRollup aggregates the days of all jobs stopped since the last
+// update again. If the rollup is empty, it is built from all jobs.
+func (r *JobRepository) UpdateUsageRollup() error {
+	start := time.Now()
+	cutoff := start.Unix()
+
+	prev, err := r.loadUsageRollupCutoff()
+	if err != nil {
+		return err
+	}
+
+	var days []int64
+	if prev != 0 {
+		rows, err := sq.Select(fmt.Sprintf("DISTINCT job.start_time - (job.start_time %% %d)", secondsPerDay)).From("job").
+			Where("job.job_state != 'running'").
+			Where("job.start_time + job.duration >= ?", prev-usageRollupMargin).
+			RunWith(r.stmtCache).Query()
+		if err != nil {
+			log.Warn("Error while querying days to aggregate")
+			return err
+		}
+		for rows.Next() {
+			var day int64
+			if err := rows.Scan(&day); err != nil {
+				rows.Close()
+				return err
+			}
+			days = append(days, day)
+		}
+		rows.Close()
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting transaction")
+		return err
+	}
+	defer tx.Rollback()
+
+	if prev == 0 {
+		if err := aggregateUsage(tx, sq.Delete("job_usage_daily"), usageRollupJobs(cutoff)); err != nil {
+			return err
+		}
+	}
+	if err := aggregateUsageDays(tx, cutoff, days); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Warn("Error while committing transaction")
+		return err
+	}
+
+	usageRollupCutoff.Store(cutoff)
+	log.Debugf("Timer UpdateUsageRollup (%d days) %s", len(days), time.Since(start))
+	return nil
+}
+
+// The cutoff of the last update, read from the database if the rollup was
+// not updated by this process yet. Zero if the rollup is empty.
+func (r *JobRepository) loadUsageRollupCutoff() (int64, error) {
+	cutoff := usageRollupCutoff.Load()
+	if cutoff == 0 {
+		if err := sq.Select("COALESCE(MAX(updated_at), 0)").From("job_usage_daily").
+			RunWith(r.stmtCache).QueryRow().Scan(&cutoff); err != nil {
+			log.Warn("Error while querying last usage rollup update")
+			return 0, err
+		}
+	}
+	return cutoff, nil
+}
+
+// Aggregates of the jobs stopped before the cutoff
+func usageRollupJobs(cutoff int64) sq.SelectBuilder {
+	day := fmt.Sprintf("job.start_time - (job.start_time %% %d)", secondsPerDay)
+	return sq.Select(day, "job.cluster", "job.hpc_user", "job.project", "COUNT(job.id)", "SUM(job.duration)",
+		"SUM(job.num_nodes)", "SUM(job.duration * job.num_nodes)",
+		"SUM(job.num_hwthreads)", "SUM(job.duration * job.num_hwthreads)",
+		"SUM(job.num_acc)", "SUM(job.duration * job.num_acc)",
+		"SUM(job.energy)", fmt.Sprint(cutoff)).
+		From("job").
+		Where("job.job_state != 'running'").
+		Where("job.deleted_at IS NULL").
+		Where("job.start_time + job.duration < ?", cutoff).
+		GroupBy(day, "job.cluster", "job.hpc_user", "job.project")
+}
+
+// Replace the aggregates deleted by `del` with the aggregates of `jobs`
+func aggregateUsage(tx *sqlx.Tx, del sq.DeleteBuilder, jobs sq.SelectBuilder) error {
+	if _, err := del.RunWith(tx).Exec(); err != nil {
+		log.Warn("Error while deleting outdated usage aggregates")
+		return err
+	}
+
+	if _, err := sq.Insert("job_usage_daily").
+		Columns("start_time", "cluster", "hpc_user", "project", "total_jobs", "total_walltime",
+			"total_nodes", "node_seconds", "total_cores", "core_seconds", "total_accs", "acc_seconds",
+			"energy", "updated_at").
+		Select(jobs).
+		RunWith(tx).Exec(); err != nil {
+		log.Warn("Error while aggregating job usage")
+		return err
+	}
+	return nil
+}
+
+func aggregateUsageDays(tx *sqlx.Tx, cutoff int64, days []int64) error {
+	jobs := usageRollupJobs(cutoff)
+	for _, d := range days {
+		if err := aggregateUsage(tx, sq.Delete("job_usage_daily").Where("start_time = ?", d),
+			jobs.Where("job.start_time >= ?", d).Where("job.start_time < ?", d+secondsPerDay)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Aggregate the days of the start times again, e.g. after jobs were deleted
+// or restored. Nothing is done if the rollup is empty.
+func (r *JobRepository) refreshUsageRollup(startTimes ...int64) error {
+	cutoff, err := r.loadUsageRollupCutoff()
+	if err != nil || cutoff == 0 {
+		return err
+	}
+
+	days := make([]int64, 0, len(startTimes))
+	for _, t := range startTimes {
+		if d := t - (t % secondsPerDay); !slices.Contains(days, d) {
+			days = append(days, d)
+		}
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		log.Warn("Error while starting transaction")
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := aggregateUsageDays(tx, cutoff, days); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// UpdateUsageRollup only aggregates the days of jobs stopped since the last
+// update. A job inserted or stopped with an end before the cutoff, e.g. an
+// imported job or a stop reported late, is missing until its day is
+// aggregated again.
+func (r *JobRepository) missingInUsageRollup(startTime int64, duration int32) bool {
+	if !config.Keys.EnableUsageRollup {
+		return false
+	}
+
+	cutoff, err := r.loadUsageRollupCutoff()
+	return err == nil && startTime+int64(duration) < cutoff
+}
+
+// Start time of the job with the database id `jobId` and whether it is
+// missing in the rollup after it was stopped with `duration`.
+func (r *JobRepository) stoppedJobMissingInUsageRollup(
+	runner sq.BaseRunner,
+	jobId int64,
+	duration int32,
+) (startTime int64, missing bool, err error) {
+	if !config.Keys.EnableUsageRollup {
+		return 0, false, nil
+	}
+
+	if err = sq.Select("job.start_time").From("job").Where("job.id = ?", jobId).
+		RunWith(runner).QueryRow().Scan(&startTime); err != nil {
+		log.Warnf("Error while querying start time of job %d", jobId)
+		return 0, false, err
+	}
+	return startTime, r.missingInUsageRollup(startTime, duration), nil
+}
+
+// Aggregate the days of jobs missing in the rollup again. A failure is only
+// logged, as the jobs themselves were stored.
+func (r *JobRepository) refreshUsageRollupOfJobs(startTimes ...int64) {
+	if len(startTimes) == 0 {
+		return
+	}
+	if err := r.refreshUsageRollup(startTimes...); err != nil {
+		log.Warnf("Error while aggregating usage of late jobs: %v", err)
+	}
+}
+
+// DeleteUsageRollupBefore removes the aggregates of days before the day of
+// `startTime`, e.g. after deleting old jobs.
+func (r *JobRepository) DeleteUsageRollupBefore(startTime int64) error {
+	if _, err := sq.Delete("job_usage_daily").
+		Where("start_time < ?", startTime-(startTime%secondsPerDay)).
+		RunWith(r.stmtCache).Exec(); err != nil {
+		log.Warn("Error while deleting usage aggregates")
+		return err
+	}
+	return nil
+}
+
+// The rollup can answer the filters only if they refer to the cluster, user,
+// project and to whole days of the start time.
+func usageRollupApplies(filter []*model.JobFilter) bool {
+	if !config.Keys.EnableUsageRollup || usageRollupCutoff.Load() == 0 {
+		return false
+	}
+
+	for _, f := range filter {
+		if f.Tags != nil || f.JobID != nil || f.ArrayJobID != nil || f.JobName != nil || f.Search != nil ||
+			f.Partition != nil || f.Duration != nil || f.Energy != nil || f.MinRunningFor != nil ||
+			f.NumNodes != nil || f.NumAccelerators != nil || f.NumHWThreads != nil || f.State != nil ||
+			f.MetricStats != nil || f.Exclusive != nil || f.Node != nil || f.SavedFilter != nil ||
+			f.Collection != nil || f.SubmitTime != nil || f.WaitTime != nil {
+			return false
+		}
+
+		if st := f.StartTime; st != nil {
+			if st.From == nil && st.To == nil && st.Range != "" {
+				return false
+			}
+			if (st.From != nil && st.From.Unix()%secondsPerDay != 0) ||
+				(st.To != nil && (st.To.Unix()+1)%secondsPerDay != 0) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// The rollup rows have no job id, so the jobs a user only sees through
+// collections shared with them can not be told apart in it. Such users get
+// their statistics from the job table.
+func (r *JobRepository) sharesCollectionsWith(user *schema.User) bool {
+	if user == nil || user.HasAnyRole([]schema.Role{schema.RoleAdmin, schema.RoleSupport}) ||
+		(len(user.Roles) == 1 && user.HasRole(schema.RoleApi)) {
+		return false
+	}
+
+	var count int
+	if err := sq.Select("COUNT(*)").From("job_collection_share").Where(sharedWithUser(user)).
+		RunWith(r.stmtCache).QueryRow().Scan(&count); err != nil {
+		log.Warnf("Error while looking up collections shared with '%s': %v", user.Username, err)
+		return true
+	}
+	return count != 0
+}
+
+// Same result columns as buildStatsQuery, computed from the rollup and the
+// jobs not included in it.
+func (r *JobRepository) buildRollupStatsQuery(
+	filter []*model.JobFilter,
+	col string,
+) sq.SelectBuilder {
+	castType := r.getCastType()
+	now, cutoff := time.Now().Unix(), usageRollupCutoff.Load()
+
+	rollup := sq.Select("job.cluster", "job.hpc_user", "job.project", "job.start_time", "job.total_jobs",
+		"job.total_walltime", "job.total_nodes", "job.node_seconds", "job.total_cores", "job.core_seconds",
+		"job.total_accs", "job.acc_seconds", "NULL AS id", "NULL AS deleted_at").
+		From("job_usage_daily AS job")
+
+	walltime := fmt.Sprintf("(CASE WHEN job.job_state = 'running' THEN %d - job.start_time ELSE job.duration END)", now)
+	recent := sq.Select("job.cluster", "job.hpc_user", "job.project", "job.start_time", "1",
+		walltime, "job.num_nodes", walltime+" * job.num_nodes", "job.num_hwthreads", walltime+" * job.num_hwthreads",
+		"job.num_acc", walltime+" * job.num_acc", "job.id", "job.deleted_at").
+		From("job").
+		Where("(job.job_state = 'running' OR job.start_time + job.duration >= ?)", cutoff)
+
+	for _, f := range filter {
+		rollup = BuildWhereClause(f, rollup)
+		recent = BuildWhereClause(f, recent)
+	}
+
+	// Placeholders are numbered by the outer query
+	recentSql, recentArgs, _ := recent.PlaceholderFormat(sq.Question).ToSql()
+	jobs := rollup.Suffix("UNION ALL "+recentSql, recentArgs...).PlaceholderFormat(sq.Question)
+
+	columns := []string{
+		fmt.Sprintf(`CAST(COALESCE(SUM(job.total_jobs), 0) as %s)`, castType),
+		fmt.Sprintf(`CAST(ROUND(SUM(job.total_walltime) / 3600) as %s)`, castType),
+		fmt.Sprintf(`CAST(SUM(job.total_nodes) as %s)`, castType),
+		fmt.Sprintf(`CAST(ROUND(SUM(job.node_seconds) / 3600) as %s)`, castType),
+		fmt.Sprintf(`CAST(SUM(job.total_cores) as %s)`, castType),
+		fmt.Sprintf(`CAST(ROUND(SUM(job.core_seconds) / 3600) as %s)`, castType),
+		fmt.Sprintf(`CAST(SUM(job.total_accs) as %s)`, castType),
+		fmt.Sprintf(`CAST(ROUND(SUM(job.acc_seconds) / 3600) as %s)`, castType),
+	}
+
+	if col != "" {
+		// Scan columns: id, totalJobs, name, totalWalltime, totalNodes, totalNodeHours, totalCores, totalCoreHours, totalAccs, totalAccHours
+		return sq.Select(col, columns[0]+" as totalJobs", "MAX(hpc_user.name) as name",
+			columns[1]+" as totalWalltime", columns[2]+" as totalNodes", columns[3]+" as totalNodeHours",
+			columns[4]+" as totalCores", columns[5]+" as totalCoreHours",
+			columns[6]+" as totalAccs", columns[7]+" as totalAccHours",
+		).FromSelect(jobs, "job").LeftJoin("hpc_user ON hpc_user.username = job.hpc_user").GroupBy(col)
+	}
+
+	// Scan columns: totalJobs, totalWalltime, totalNodes, totalNodeHours, totalCores, totalCoreHours, totalAccs, totalAccHours
+	return sq.Select(columns...).FromSelect(jobs, "job")
+}
@@ -152,7 +152,7 @@ func (r *UserRepository) UpdateUser(dbUser *schema.User, user *schema.User) erro
 }
 
 func (r *UserRepository) DelUser(username string) error {
-	_, err := r.DB.Exec(`DELETE FROM hpc_user WHERE hpc_user.username = ?`, username)
+	_, err := r.DB.Exec(r.DB.Rebind(`DELETE FROM hpc_user WHERE hpc_user.username = ?`), username)
 	if err != nil {
 		log.Errorf("Error while deleting user '%s' from DB", username)
 		return err
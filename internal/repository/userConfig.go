@@ -33,7 +33,7 @@ func GetUserCfgRepo() *UserCfgRepo {
 	userCfgRepoOnce.Do(func() {
 		db := GetConnection()
 
-		lookupConfigStmt, err := db.DB.Preparex(`SELECT confkey, value FROM configuration WHERE configuration.username = ?`)
+		lookupConfigStmt, err := db.DB.Preparex(db.DB.Rebind(`SELECT confkey, value FROM configuration WHERE configuration.username = ?`))
 		if err != nil {
 			log.Fatalf("db.DB.Preparex() error: %v", err)
 		}
@@ -127,7 +127,13 @@ func (uCfg *UserCfgRepo) UpdateConfig(
 		return nil
 	}
 
-	if _, err := uCfg.DB.Exec(`REPLACE INTO configuration (username, confkey, value) VALUES (?, ?, ?)`, user.Username, key, value); err != nil {
+	query := `REPLACE INTO configuration (username, confkey, value) VALUES (?, ?, ?)`
+	if uCfg.DB.DriverName() == "postgres" {
+		query = `INSERT INTO configuration (username, confkey, value) VALUES ($1, $2, $3)
+			ON CONFLICT (username, confkey) DO UPDATE SET value = EXCLUDED.value`
+	}
+
+	if _, err := uCfg.DB.Exec(query, user.Username, key, value); err != nil {
 		log.Warnf("Error while replacing user config in DB for user '%v'", user.Username)
 		return err
 	}
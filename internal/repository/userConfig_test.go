@@ -37,12 +37,11 @@ func setupUserTest(t *testing.T) *UserCfgRepo {
 }`
 
 	log.Init("info", true)
-	dbfilepath := "testdata/job.db"
-	err := MigrateDB("sqlite3", dbfilepath)
+	err := MigrateDB("sqlite3", testDB)
 	if err != nil {
 		t.Fatal(err)
 	}
-	Connect("sqlite3", dbfilepath)
+	Connect("sqlite3", testDB)
 
 	tmpdir := t.TempDir()
 	cfgFilePath := filepath.Join(tmpdir, "config.json")
@@ -0,0 +1,117 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Upper limit for the number of buckets of a utilization time series.
+const maxUtilizationBuckets = 10000
+
+// ClusterUtilization returns the nodes, cores and accelerators allocated on a
+// cluster per time bucket of `bucketSize` seconds between `from` and `to`.
+// The values are averaged over the time of the bucket, so that a job running
+// for half of the bucket counts half. The subcluster and the partition are
+// optional. Running jobs count until now.
+func (r *JobRepository) ClusterUtilization(
+	cluster string,
+	subCluster *string,
+	partition *string,
+	from, to time.Time,
+	bucketSize int,
+) ([]*model.UtilizationPoint, error) {
+	start := time.Now()
+	begin, end, size := from.Unix(), to.Unix(), int64(bucketSize)
+	if size <= 0 {
+		return nil, errors.New("bucket size has to be positive")
+	}
+	if begin >= end {
+		return nil, errors.New("start of time range has to be before its end")
+	}
+	numBuckets := (end - begin + size - 1) / size
+	if numBuckets > maxUtilizationBuckets {
+		return nil, fmt.Errorf("too many buckets (%d), the maximum is %d", numBuckets, maxUtilizationBuckets)
+	}
+
+	now := time.Now().Unix()
+	q := sq.Select("job.start_time",
+		fmt.Sprintf("(CASE WHEN job.job_state = 'running' THEN %d ELSE job.start_time + job.duration END)", now),
+		"job.num_nodes", "COALESCE(job.num_hwthreads, 0)", "COALESCE(job.num_acc, 0)").
+		From("job").
+		Where("job.cluster = ?", cluster).
+		Where("job.deleted_at IS NULL").
+		Where("job.start_time < ?", end).
+		Where("(job.job_state = 'running' OR job.start_time + job.duration > ?)", begin)
+	if subCluster != nil {
+		q = q.Where("job.subcluster = ?", *subCluster)
+	}
+	if partition != nil {
+		q = q.Where("job.cluster_partition = ?", *partition)
+	}
+
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		queryString, queryVars, _ := q.ToSql()
+		log.Errorf("Error while running query '%s' %v: %v", queryString, queryVars, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	points := make([]*model.UtilizationPoint, numBuckets)
+	for i := range points {
+		points[i] = &model.UtilizationPoint{Time: time.Unix(begin+int64(i)*size, 0)}
+	}
+
+	for rows.Next() {
+		var jobStart, jobEnd, nodes, cores, accs int64
+		if err := rows.Scan(&jobStart, &jobEnd, &nodes, &cores, &accs); err != nil {
+			log.Warn("Error while scanning rows")
+			return nil, err
+		}
+
+		// Running jobs can start after now
+		jobStart, jobEnd = max(jobStart, begin), min(jobEnd, end)
+		if jobEnd <= jobStart {
+			continue
+		}
+		for i := (jobStart - begin) / size; i < numBuckets; i++ {
+			bucketStart := begin + i*size
+			if bucketStart >= jobEnd {
+				break
+			}
+
+			// Seconds the job ran within the bucket
+			overlap := float64(min(jobEnd, bucketStart+size) - max(jobStart, bucketStart))
+			p := points[i]
+			p.Jobs += overlap
+			p.Nodes += overlap * float64(nodes)
+			p.Cores += overlap * float64(cores)
+			p.Accs += overlap * float64(accs)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, p := range points {
+		// The last bucket ends with the time range
+		bucketStart := begin + int64(i)*size
+		length := float64(min(end, bucketStart+size) - bucketStart)
+		p.Jobs /= length
+		p.Nodes /= length
+		p.Cores /= length
+		p.Accs /= length
+	}
+
+	log.Debugf("Timer ClusterUtilization %s", time.Since(start))
+	return points, nil
+}
@@ -0,0 +1,302 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	webhookRepoOnce     sync.Once
+	webhookRepoInstance *WebhookRepository
+)
+
+// WebhookRepository stores the webhooks registered by admins and the queue
+// of deliveries to them. Deliveries stay in the table after they are
+// finished and serve as delivery log.
+type WebhookRepository struct {
+	DB        *sqlx.DB
+	stmtCache *sq.StmtCache
+	driver    string
+}
+
+func GetWebhookRepository() *WebhookRepository {
+	webhookRepoOnce.Do(func() {
+		db := GetConnection()
+
+		webhookRepoInstance = &WebhookRepository{
+			DB:     db.DB,
+			driver: db.Driver,
+
+			stmtCache: sq.NewStmtCache(db.DB),
+		}
+	})
+	return webhookRepoInstance
+}
+
+var webhookColumns []string = []string{
+	"webhook.id", "webhook.name", "webhook.url", "webhook.secret", "webhook.events",
+	"webhook.active", "webhook.created_by", "webhook.created_at",
+}
+
+func scanWebhook(row interface{ Scan(...interface{}) error }) (*schema.Webhook, error) {
+	h := &schema.Webhook{}
+	if err := row.Scan(&h.ID, &h.Name, &h.URL, &h.Secret, &h.RawEvents, &h.Active, &h.CreatedBy, &h.CreatedAt); err != nil {
+		if err != sql.ErrNoRows {
+			log.Warn("Error while scanning rows (Webhook)")
+		}
+		return nil, err
+	}
+
+	h.Events = make([]schema.WebhookEvent, 0)
+	for _, e := range strings.Split(h.RawEvents, ",") {
+		if e != "" {
+			h.Events = append(h.Events, schema.WebhookEvent(e))
+		}
+	}
+	return h, nil
+}
+
+var webhookDeliveryColumns []string = []string{
+	"webhook_delivery.id", "webhook_delivery.webhook_id", "webhook_delivery.event", "webhook_delivery.payload",
+	"webhook_delivery.status", "webhook_delivery.attempts", "webhook_delivery.next_attempt",
+	"webhook_delivery.response_status", "webhook_delivery.error", "webhook_delivery.created_at",
+	"webhook_delivery.delivered_at",
+}
+
+func scanWebhookDelivery(row interface{ Scan(...interface{}) error }) (*schema.WebhookDelivery, error) {
+	d := &schema.WebhookDelivery{}
+	if err := row.Scan(&d.ID, &d.WebhookID, &d.Event, &d.Payload, &d.Status, &d.Attempts, &d.NextAttempt,
+		&d.ResponseStatus, &d.Error, &d.CreatedAt, &d.DeliveredAt); err != nil {
+		log.Warn("Error while scanning rows (WebhookDelivery)")
+		return nil, err
+	}
+	return d, nil
+}
+
+// Check the name, the URL and the events of a webhook and encode the events.
+func checkWebhook(h *schema.Webhook) error {
+	if h.Name == "" {
+		return errors.New("a webhook needs a name")
+	}
+	if u, err := url.Parse(h.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook url: %#v", h.URL)
+	}
+	if len(h.Events) == 0 {
+		return errors.New("a webhook needs at least one event")
+	}
+
+	events := make([]string, 0, len(h.Events))
+	for _, e := range h.Events {
+		if !e.Valid() {
+			return fmt.Errorf("invalid webhook event: %#v", e)
+		}
+		events = append(events, string(e))
+	}
+	h.RawEvents = strings.Join(events, ",")
+	return nil
+}
+
+// The active flag is stored as SMALLINT
+func activeFlag(active bool) int {
+	if active {
+		return 1
+	}
+	return 0
+}
+
+// Add registers a new webhook and sets its id.
+func (r *WebhookRepository) Add(h *schema.Webhook) error {
+	if err := checkWebhook(h); err != nil {
+		return err
+	}
+
+	q := sq.Insert("webhook").
+		Columns("name", "url", "secret", "events", "active", "created_by", "created_at").
+		Values(h.Name, h.URL, h.Secret, h.RawEvents, activeFlag(h.Active), h.CreatedBy, h.CreatedAt)
+
+	var err error
+	if h.ID, err = insertReturningId(r.DB, q); err != nil {
+		log.Errorf("Error while adding webhook '%s': %v", h.Name, err)
+		return err
+	}
+
+	return nil
+}
+
+// Update replaces the name, the URL, the secret, the events and the active
+// flag of the webhook with the id of `h`.
+func (r *WebhookRepository) Update(h *schema.Webhook) error {
+	if err := checkWebhook(h); err != nil {
+		return err
+	}
+
+	res, err := sq.Update("webhook").
+		Set("name", h.Name).
+		Set("url", h.URL).
+		Set("secret", h.Secret).
+		Set("events", h.RawEvents).
+		Set("active", activeFlag(h.Active)).
+		Where("webhook.id = ?", h.ID).
+		RunWith(r.stmtCache).Exec()
+	if err != nil {
+		log.Errorf("Error while updating webhook %d: %v", h.ID, err)
+		return err
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Delete removes the webhook together with its deliveries.
+func (r *WebhookRepository) Delete(id int64) error {
+	res, err := sq.Delete("webhook").Where("webhook.id = ?", id).RunWith(r.stmtCache).Exec()
+	if err != nil {
+		log.Errorf("Error while deleting webhook %d: %v", id, err)
+		return err
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) Get(id int64) (*schema.Webhook, error) {
+	return scanWebhook(sq.Select(webhookColumns...).From("webhook").Where("webhook.id = ?", id).
+		RunWith(r.stmtCache).QueryRow())
+}
+
+// List returns all webhooks ordered by name.
+func (r *WebhookRepository) List() ([]*schema.Webhook, error) {
+	rows, err := sq.Select(webhookColumns...).From("webhook").OrderBy("webhook.name").
+		RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while listing webhooks: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	hooks := make([]*schema.Webhook, 0)
+	for rows.Next() {
+		h, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, h)
+	}
+
+	return hooks, rows.Err()
+}
+
+// AddDelivery queues a delivery and sets its id.
+func (r *WebhookRepository) AddDelivery(d *schema.WebhookDelivery) error {
+	q := sq.Insert("webhook_delivery").
+		Columns("webhook_id", "event", "payload", "status", "attempts", "next_attempt", "response_status",
+			"error", "created_at", "delivered_at").
+		Values(d.WebhookID, d.Event, d.Payload, d.Status, d.Attempts, d.NextAttempt, d.ResponseStatus,
+			d.Error, d.CreatedAt, d.DeliveredAt)
+
+	var err error
+	if d.ID, err = insertReturningId(r.DB, q); err != nil {
+		log.Errorf("Error while adding delivery to webhook %d: %v", d.WebhookID, err)
+		return err
+	}
+
+	return nil
+}
+
+// DueDeliveries returns up to `limit` pending deliveries whose next attempt
+// is due at `now`, the oldest first. Webhooks backing off after a failed
+// attempt are skipped until the retry is due.
+func (r *WebhookRepository) DueDeliveries(now int64, limit uint64) ([]*schema.WebhookDelivery, error) {
+	return r.queryDeliveries(sq.Select(webhookDeliveryColumns...).From("webhook_delivery").
+		Where("webhook_delivery.status = ?", schema.WebhookDeliveryPending).
+		Where("webhook_delivery.next_attempt <= ?", now).
+		Where(`webhook_delivery.webhook_id NOT IN (SELECT retry.webhook_id FROM webhook_delivery AS retry
+			WHERE retry.status = ? AND retry.attempts > 0 AND retry.next_attempt > ?)`,
+			schema.WebhookDeliveryPending, now).
+		OrderBy("webhook_delivery.next_attempt ASC", "webhook_delivery.id ASC").
+		Limit(limit))
+}
+
+// UpdateDelivery stores the result of a delivery attempt.
+func (r *WebhookRepository) UpdateDelivery(d *schema.WebhookDelivery) error {
+	if _, err := sq.Update("webhook_delivery").
+		Set("status", d.Status).
+		Set("attempts", d.Attempts).
+		Set("next_attempt", d.NextAttempt).
+		Set("response_status", d.ResponseStatus).
+		Set("error", d.Error).
+		Set("delivered_at", d.DeliveredAt).
+		Where("webhook_delivery.id = ?", d.ID).
+		RunWith(r.stmtCache).Exec(); err != nil {
+		log.Errorf("Error while updating webhook delivery %d: %v", d.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+// ListDeliveries returns the deliveries to a webhook, newest first.
+func (r *WebhookRepository) ListDeliveries(webhookId int64, page *model.PageRequest) ([]*schema.WebhookDelivery, error) {
+	q := sq.Select(webhookDeliveryColumns...).From("webhook_delivery").
+		Where("webhook_delivery.webhook_id = ?", webhookId).
+		OrderBy("webhook_delivery.created_at DESC", "webhook_delivery.id DESC")
+	if page != nil && page.ItemsPerPage != -1 {
+		limit := uint64(page.ItemsPerPage)
+		q = q.Offset((uint64(page.Page) - 1) * limit).Limit(limit)
+	}
+
+	return r.queryDeliveries(q)
+}
+
+func (r *WebhookRepository) queryDeliveries(q sq.SelectBuilder) ([]*schema.WebhookDelivery, error) {
+	rows, err := q.RunWith(r.stmtCache).Query()
+	if err != nil {
+		log.Errorf("Error while running query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]*schema.WebhookDelivery, 0)
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// DeleteDeliveriesBefore removes the finished deliveries created before the
+// timestamp from the delivery log.
+func (r *WebhookRepository) DeleteDeliveriesBefore(ts int64) (int64, error) {
+	res, err := sq.Delete("webhook_delivery").
+		Where("webhook_delivery.status != ?", schema.WebhookDeliveryPending).
+		Where("webhook_delivery.created_at < ?", ts).
+		RunWith(r.stmtCache).Exec()
+	if err != nil {
+		log.Errorf("Error while deleting webhook deliveries: %v", err)
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,64 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"testing"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func TestDueDeliveries(t *testing.T) {
+	setup(t)
+	r := GetWebhookRepository()
+
+	var ids []int64
+	for _, name := range []string{"failing", "working"} {
+		h := &schema.Webhook{
+			Name: name, URL: "https://example.com/" + name, Secret: "secret",
+			Events: []schema.WebhookEvent{schema.WebhookEventJobStarted}, Active: true,
+		}
+		noErr(t, r.Add(h))
+		ids = append(ids, h.ID)
+	}
+	t.Cleanup(func() {
+		for _, id := range ids {
+			r.Delete(id)
+		}
+	})
+
+	var deliveries []*schema.WebhookDelivery
+	for _, id := range []int64{ids[0], ids[0], ids[1]} {
+		d := &schema.WebhookDelivery{
+			WebhookID: id, Event: schema.WebhookEventJobStarted, Payload: "{}",
+			Status: schema.WebhookDeliveryPending, NextAttempt: 100, CreatedAt: 100,
+		}
+		noErr(t, r.AddDelivery(d))
+		deliveries = append(deliveries, d)
+	}
+
+	due, err := r.DueDeliveries(200, 10)
+	noErr(t, err)
+	if len(due) != 3 {
+		t.Fatalf("want 3 due deliveries, got %d", len(due))
+	}
+
+	// The failing webhook backs off with all of its deliveries
+	failed := deliveries[0]
+	failed.Attempts, failed.NextAttempt, failed.Error = 1, 300, "unexpected response status"
+	noErr(t, r.UpdateDelivery(failed))
+
+	due, err = r.DueDeliveries(200, 10)
+	noErr(t, err)
+	if len(due) != 1 || due[0].ID != deliveries[2].ID {
+		t.Errorf("want the delivery of the working webhook only, got %#v", due)
+	}
+
+	due, err = r.DueDeliveries(300, 10)
+	noErr(t, err)
+	if len(due) != 3 {
+		t.Errorf("want 3 due deliveries after the backoff, got %d", len(due))
+	}
+}
@@ -231,6 +231,9 @@ func buildFilterPresets(query url.Values) map[string]interface{} {
 	if query.Get("jobName") != "" {
 		filterPresets["jobName"] = query.Get("jobName")
 	}
+	if query.Get("search") != "" {
+		filterPresets["search"] = query.Get("search")
+	}
 	if len(query["user"]) != 0 {
 		if len(query["user"]) == 1 {
 			filterPresets["user"] = query.Get("user")
@@ -423,6 +426,9 @@ func HandleSearchBar(rw http.ResponseWriter, r *http.Request, buildInfo web.Buil
 				fromTime := strconv.FormatInt((time.Now().Unix() - int64(30*24*3600)), 10)
 
 				http.Redirect(rw, r, "/monitoring/jobs/?startTime="+fromTime+"-"+untilTime+"&jobName="+url.QueryEscape(strings.Trim(splitSearch[1], " ")), http.StatusFound) // All Users: Redirect to Tablequery
+			case "search":
+				// Full-text search over job name, job script and meta data
+				http.Redirect(rw, r, "/monitoring/jobs/?search="+url.QueryEscape(strings.Trim(splitSearch[1], " ")), http.StatusFound) // All Users: Redirect to Tablequery
 			case "projectId":
 				http.Redirect(rw, r, "/monitoring/jobs/?projectMatch=eq&project="+url.QueryEscape(strings.Trim(splitSearch[1], " ")), http.StatusFound) // All Users: Redirect to Tablequery
 			case "arrayJobId":
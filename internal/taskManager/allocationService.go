@@ -0,0 +1,39 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package taskManager
+
+import (
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/internal/webhook"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/go-co-op/gocron/v2"
+)
+
+func RegisterAllocationWorker() {
+	var frequency string
+	if config.Keys.CronFrequency != nil && config.Keys.CronFrequency.AllocationWorker != "" {
+		frequency = config.Keys.CronFrequency.AllocationWorker
+	} else {
+		frequency = "1h"
+	}
+	d, _ := time.ParseDuration(frequency)
+	log.Infof("Register Over-Budget Allocation service with %s interval", frequency)
+
+	s.NewJob(gocron.DurationJob(d),
+		gocron.NewTask(
+			func() {
+				start := time.Now()
+				log.Printf("Over-budget tagging started at %s", start.Format(time.RFC3339))
+				// Jobs of allocations ended recently can still be running
+				n, err := repository.GetAllocationRepository().TagOverBudgetJobs(24*time.Hour, webhook.FireJobTagged)
+				if err != nil {
+					log.Warnf("Error while tagging jobs over budget: %s", err.Error())
+				}
+				log.Printf("Over-budget tagging is done, tagged %d jobs and took %s", n, time.Since(start))
+			}))
+}
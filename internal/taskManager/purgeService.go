@@ -0,0 +1,59 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package taskManager
+
+import (
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/metricDataDispatcher"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	"github.com/go-co-op/gocron/v2"
+)
+
+// RegisterPurgeService removes deleted jobs from the database after the
+// grace period. Their archive is moved to `moveTo` if it is not empty, as
+// for the retention policy 'move', and removed otherwise.
+func RegisterPurgeService(gracePeriod int, moveTo string) {
+	log.Info("Register purge service for deleted jobs")
+
+	s.NewJob(gocron.DailyJob(1, gocron.NewAtTimes(gocron.NewAtTime(04, 30, 0))),
+		gocron.NewTask(
+			func() {
+				before := time.Now().Unix() - int64(gracePeriod*24*3600)
+				jobs, err := jobRepo.PurgeDeletedJobs(before)
+				if err != nil {
+					log.Errorf("Error while purging deleted jobs from db: %v", err)
+					return
+				}
+				if len(jobs) == 0 {
+					return
+				}
+
+				// Jobs may have been removed from the archive by the retention
+				// service already
+				ar := archive.GetHandle()
+				archived := make([]*schema.Job, 0, len(jobs))
+				for _, job := range jobs {
+					if ar.Exists(job) {
+						archived = append(archived, job)
+					}
+				}
+				if moveTo != "" {
+					ar.Move(archived, moveTo)
+				} else {
+					ar.CleanUp(archived)
+				}
+				for _, job := range jobs {
+					metricDataDispatcher.InvalidateDiskCache(job)
+				}
+
+				log.Infof("Purge: Removed %d deleted jobs from db", len(jobs))
+				if err = jobRepo.Optimize(); err != nil {
+					log.Errorf("Error occured in db optimization: %v", err)
+				}
+			}))
+}
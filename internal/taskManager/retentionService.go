@@ -19,23 +19,24 @@ func RegisterRetentionDeleteService(age int, includeDB bool) {
 		gocron.NewTask(
 			func() {
 				startTime := time.Now().Unix() - int64(age*24*3600)
-				jobs, err := jobRepo.FindJobsBetween(0, startTime)
-				if err != nil {
-					log.Warnf("Error while looking for retention jobs: %s", err.Error())
-				}
-				archive.GetHandle().CleanUp(jobs)
 
+				// The archive of jobs deleted in the db is removed by the
+				// purge service once they cannot be restored anymore
 				if includeDB {
-					cnt, err := jobRepo.DeleteJobsBefore(startTime)
+					cnt, err := jobRepo.DeleteJobsBefore(startTime, nil)
 					if err != nil {
 						log.Errorf("Error while deleting retention jobs from db: %s", err.Error())
 					} else {
-						log.Infof("Retention: Removed %d jobs from db", cnt)
-					}
-					if err = jobRepo.Optimize(); err != nil {
-						log.Errorf("Error occured in db optimization: %s", err.Error())
+						log.Infof("Retention: Marked %d jobs as deleted in db", cnt)
 					}
+					return
 				}
+
+				jobs, err := jobRepo.FindJobsBetween(0, startTime)
+				if err != nil {
+					log.Warnf("Error while looking for retention jobs: %s", err.Error())
+				}
+				archive.GetHandle().CleanUp(jobs)
 			}))
 }
 
@@ -46,22 +47,23 @@ func RegisterRetentionMoveService(age int, includeDB bool, location string) {
 		gocron.NewTask(
 			func() {
 				startTime := time.Now().Unix() - int64(age*24*3600)
-				jobs, err := jobRepo.FindJobsBetween(0, startTime)
-				if err != nil {
-					log.Warnf("Error while looking for retention jobs: %s", err.Error())
-				}
-				archive.GetHandle().Move(jobs, location)
 
+				// The archive of jobs deleted in the db is moved by the
+				// purge service once they cannot be restored anymore
 				if includeDB {
-					cnt, err := jobRepo.DeleteJobsBefore(startTime)
+					cnt, err := jobRepo.DeleteJobsBefore(startTime, nil)
 					if err != nil {
 						log.Errorf("Error while deleting retention jobs from db: %v", err)
 					} else {
-						log.Infof("Retention: Removed %d jobs from db", cnt)
-					}
-					if err = jobRepo.Optimize(); err != nil {
-						log.Errorf("Error occured in db optimization: %v", err)
+						log.Infof("Retention: Marked %d jobs as deleted in db", cnt)
 					}
+					return
 				}
+
+				jobs, err := jobRepo.FindJobsBetween(0, startTime)
+				if err != nil {
+					log.Warnf("Error while looking for retention jobs: %s", err.Error())
+				}
+				archive.GetHandle().Move(jobs, location)
 			}))
 }
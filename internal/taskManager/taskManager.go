@@ -69,6 +69,12 @@ func Start() {
 			cfg.Retention.Location)
 	}
 
+	var purgeMoveTo string
+	if cfg.Retention.Policy == "move" {
+		purgeMoveTo = cfg.Retention.Location
+	}
+	RegisterPurgeService(config.Keys.DeletedJobsGracePeriod, purgeMoveTo)
+
 	if cfg.Compression > 0 {
 		RegisterCompressionService(cfg.Compression)
 	}
@@ -82,6 +88,14 @@ func Start() {
 	RegisterFootprintWorker()
 	RegisterUpdateDurationWorker()
 
+	if config.Keys.EnableUsageRollup {
+		RegisterUsageRollupWorker()
+	}
+
+	if config.Keys.TagJobsOverBudget {
+		RegisterAllocationWorker()
+	}
+
 	s.Start()
 }
 
@@ -0,0 +1,37 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package taskManager
+
+import (
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/go-co-op/gocron/v2"
+)
+
+func RegisterUsageRollupWorker() {
+	var frequency string
+	if config.Keys.CronFrequency != nil && config.Keys.CronFrequency.UsageRollupWorker != "" {
+		frequency = config.Keys.CronFrequency.UsageRollupWorker
+	} else {
+		frequency = "15m"
+	}
+	d, _ := time.ParseDuration(frequency)
+	log.Infof("Register Usage Rollup service with %s interval", frequency)
+
+	s.NewJob(gocron.DurationJob(d),
+		gocron.NewTask(
+			func() {
+				start := time.Now()
+				log.Printf("Usage rollup update started at %s", start.Format(time.RFC3339))
+				if err := jobRepo.UpdateUsageRollup(); err != nil {
+					log.Warnf("Error while updating usage rollup: %s", err.Error())
+				}
+				log.Printf("Usage rollup update is done and took %s", time.Since(start))
+			}),
+		// The statistics use the rollup only after the first update
+		gocron.WithStartAt(gocron.WithStartImmediately()))
+}
@@ -0,0 +1,357 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// Events are queued as deliveries in the database, one per subscribed
+// webhook, and sent by a background worker. The worker sends the
+// deliveries of each webhook concurrently to the other webhooks. Failed
+// deliveries are retried with exponential backoff. The JSON payload is
+// signed with the secret of the webhook, the signature is sent as
+// 'X-CC-Signature: sha256=<hex>'.
+
+const (
+	maxAttempts       = 8
+	firstRetryDelay   = 30 * time.Second
+	maxRetryDelay     = time.Hour
+	pollInterval      = 10 * time.Second
+	deliveryBatchSize = 100
+	deliveryRetention = 30 * 24 * time.Hour
+	requestTimeout    = 10 * time.Second
+)
+
+var (
+	repo    *repository.WebhookRepository
+	client  = &http.Client{Timeout: requestTimeout}
+	notify  chan struct{}
+	stop    chan struct{}
+	stopped sync.WaitGroup
+
+	hooksLock sync.RWMutex
+	hooks     []*schema.Webhook
+)
+
+// Payload is the body of all webhook requests.
+type Payload struct {
+	Event     schema.WebhookEvent `json:"event"`
+	TimeStamp int64               `json:"timeStamp"` // Unix timestamp of the event
+	Data      any                 `json:"data"`
+}
+
+// Job as sent in the data of job events.
+type Job struct {
+	ID               int64           `json:"id"`
+	JobID            int64           `json:"jobId"`
+	Cluster          string          `json:"cluster"`
+	SubCluster       string          `json:"subCluster"`
+	Partition        string          `json:"partition,omitempty"`
+	User             string          `json:"user"`
+	Project          string          `json:"project"`
+	State            schema.JobState `json:"jobState"`
+	StartTime        int64           `json:"startTime"`
+	Duration         int32           `json:"duration"`
+	NumNodes         int32           `json:"numNodes"`
+	MonitoringStatus int32           `json:"monitoringStatus"`
+}
+
+func jobData(id int64, job *schema.BaseJob, startTime int64) *Job {
+	return &Job{
+		ID: id, JobID: job.JobID, Cluster: job.Cluster, SubCluster: job.SubCluster, Partition: job.Partition,
+		User: job.User, Project: job.Project, State: job.State, StartTime: startTime, Duration: job.Duration,
+		NumNodes: job.NumNodes, MonitoringStatus: job.MonitoringStatus,
+	}
+}
+
+// Start loads the webhooks and starts the delivery worker. Deliveries
+// still pending from before a restart are sent as well. Events are
+// dropped as long as Start was not called.
+func Start() error {
+	repo = repository.GetWebhookRepository()
+	if err := Reload(); err != nil {
+		repo = nil
+		return err
+	}
+
+	notify = make(chan struct{}, 1)
+	stop = make(chan struct{})
+	stopped.Add(1)
+	go worker()
+	return nil
+}
+
+// Shutdown stops the delivery worker. Pending deliveries are sent after the
+// next start.
+func Shutdown() {
+	if stop == nil {
+		return
+	}
+	close(stop)
+	stopped.Wait()
+}
+
+// Reload has to be called after webhooks were changed in the repository.
+func Reload() error {
+	list, err := repo.List()
+	if err != nil {
+		log.Warn("Error while loading webhooks")
+		return err
+	}
+
+	hooksLock.Lock()
+	hooks = list
+	hooksLock.Unlock()
+	return nil
+}
+
+func lookup(id int64) *schema.Webhook {
+	hooksLock.RLock()
+	defer hooksLock.RUnlock()
+	for _, h := range hooks {
+		if h.ID == id {
+			return h
+		}
+	}
+	return nil
+}
+
+// GenerateSecret returns a random secret for signing payloads.
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Fire queues a delivery of the event to all active webhooks subscribed to
+// it. The deliveries are stored right away, so that no event is lost if
+// cc-backend stops before they are sent, and sent by the worker. Failures
+// are only logged, an event must never fail the action that triggered it.
+func Fire(event schema.WebhookEvent, data any) {
+	if repo == nil {
+		return
+	}
+
+	hooksLock.RLock()
+	targets := make([]*schema.Webhook, 0)
+	for _, h := range hooks {
+		if h.Active && slices.Contains(h.Events, event) {
+			targets = append(targets, h)
+		}
+	}
+	hooksLock.RUnlock()
+	if len(targets) == 0 {
+		return
+	}
+
+	now := time.Now().Unix()
+	payload, err := json.Marshal(Payload{Event: event, TimeStamp: now, Data: data})
+	if err != nil {
+		log.Errorf("Error while encoding payload of webhook event '%s': %v", event, err)
+		return
+	}
+
+	for _, h := range targets {
+		d := &schema.WebhookDelivery{
+			WebhookID: h.ID, Event: event, Payload: string(payload),
+			Status: schema.WebhookDeliveryPending, NextAttempt: now, CreatedAt: now,
+		}
+		if err := repo.AddDelivery(d); err != nil {
+			log.Errorf("Error while queueing webhook event '%s' for webhook '%s'", event, h.Name)
+		}
+	}
+
+	select {
+	case notify <- struct{}{}:
+	default:
+	}
+}
+
+// FireJobEvent fires a job event for a job from the database.
+func FireJobEvent(event schema.WebhookEvent, job *schema.Job) {
+	Fire(event, map[string]any{"job": jobData(job.ID, &job.BaseJob, job.StartTime.Unix())})
+}
+
+// FireJobStarted fires the start event of a job that was inserted with the
+// database id `id`.
+func FireJobStarted(id int64, job *schema.JobMeta) {
+	Fire(schema.WebhookEventJobStarted, map[string]any{"job": jobData(id, &job.BaseJob, job.StartTime)})
+}
+
+// FireJobTagged fires the event of a tag added to a job.
+func FireJobTagged(job *schema.Job, tag *schema.Tag) {
+	Fire(schema.WebhookEventJobTagged, map[string]any{
+		"job": jobData(job.ID, &job.BaseJob, job.StartTime.Unix()),
+		"tag": tag,
+	})
+}
+
+// FireStartedJobTagged fires the event of a tag added to a job that was
+// inserted with the database id `id`.
+func FireStartedJobTagged(id int64, job *schema.JobMeta, tag *schema.Tag) {
+	Fire(schema.WebhookEventJobTagged, map[string]any{
+		"job": jobData(id, &job.BaseJob, job.StartTime),
+		"tag": tag,
+	})
+}
+
+// FireNodeStateChanged fires the event of a changed node or health state.
+func FireNodeStateChanged(node *schema.Node) {
+	Fire(schema.WebhookEventNodeStateChanged, map[string]any{"node": node})
+}
+
+func worker() {
+	defer stopped.Done()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	var lastCleanup time.Time
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-notify:
+		case <-ticker.C:
+		}
+
+		deliverDue()
+
+		if time.Since(lastCleanup) > time.Hour {
+			lastCleanup = time.Now()
+			if n, err := repo.DeleteDeliveriesBefore(time.Now().Add(-deliveryRetention).Unix()); err == nil && n > 0 {
+				log.Infof("Removed %d webhook deliveries from the delivery log", n)
+			}
+		}
+	}
+}
+
+func deliverDue() {
+	for {
+		due, err := repo.DueDeliveries(time.Now().Unix(), deliveryBatchSize)
+		if err != nil {
+			return
+		}
+
+		// The deliveries of a webhook are sent in order, the webhooks
+		// concurrently, so that a slow or failing webhook does not delay
+		// the others
+		perHook := make(map[int64][]*schema.WebhookDelivery)
+		for _, d := range due {
+			perHook[d.WebhookID] = append(perHook[d.WebhookID], d)
+		}
+
+		var wg sync.WaitGroup
+		var abort atomic.Bool
+		for _, deliveries := range perHook {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for _, d := range deliveries {
+					select {
+					case <-stop:
+						abort.Store(true)
+						return
+					default:
+					}
+
+					// After a failure, the webhook is backing off and its
+					// other deliveries are not due before the retry
+					ok, err := deliver(d)
+					if err != nil {
+						abort.Store(true)
+						return
+					}
+					if !ok {
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		if abort.Load() || len(due) < deliveryBatchSize {
+			return
+		}
+	}
+}
+
+func retryDelay(attempts int) time.Duration {
+	return min(firstRetryDelay<<(attempts-1), maxRetryDelay)
+}
+
+// Send a delivery and store its result. Returns false if the webhook is
+// backing off after a failed attempt, and the error of storing the result.
+func deliver(d *schema.WebhookDelivery) (bool, error) {
+	d.Attempts++
+	d.ResponseStatus, d.Error = 0, ""
+
+	h := lookup(d.WebhookID)
+	if h == nil || !h.Active {
+		d.Status, d.Error = schema.WebhookDeliveryFailed, "webhook was removed or deactivated"
+		return true, repo.UpdateDelivery(d)
+	}
+
+	err := post(h, d)
+	if err == nil {
+		d.Status, d.DeliveredAt = schema.WebhookDeliveryDelivered, time.Now().Unix()
+	} else if d.Error = err.Error(); d.Attempts >= maxAttempts {
+		d.Status = schema.WebhookDeliveryFailed
+		log.Warnf("Delivery %d of webhook '%s' failed after %d attempts: %s", d.ID, h.Name, d.Attempts, d.Error)
+	} else {
+		d.NextAttempt = time.Now().Add(retryDelay(d.Attempts)).Unix()
+	}
+	return err == nil || d.Status == schema.WebhookDeliveryFailed, repo.UpdateDelivery(d)
+}
+
+func post(h *schema.Webhook, d *schema.WebhookDelivery) error {
+	payload := []byte(d.Payload)
+	req, err := http.NewRequest(http.MethodPost, h.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "cc-backend")
+	req.Header.Set("X-CC-Event", string(d.Event))
+	req.Header.Set("X-CC-Delivery", strconv.FormatInt(d.ID, 10))
+	req.Header.Set("X-CC-Signature", "sha256="+Sign(h.Secret, payload))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	d.ResponseStatus = res.StatusCode
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status: %s", res.Status)
+	}
+	return nil
+}
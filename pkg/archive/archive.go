@@ -25,7 +25,11 @@ type ArchiveBackend interface {
 
 	LoadJobMeta(job *schema.Job) (*schema.JobMeta, error)
 
-	LoadJobData(job *schema.Job) (schema.JobData, error)
+	// Loads the metric data of a job. If resolution is not zero and the
+	// backend stores downsampled levels, the coarsest level that still
+	// satisfies the requested resolution is returned instead of the full
+	// resolution data.
+	LoadJobData(job *schema.Job, resolution int) (schema.JobData, error)
 
 	LoadClusterCfg(name string) (*schema.Cluster, error)
 
@@ -179,3 +183,20 @@ func UpdateTags(job *schema.Job, tags []*schema.Tag) error {
 
 	return ar.StoreJobMeta(jobMeta)
 }
+
+// If the job is archived, find its `meta.json` file and override the comments
+// list in that JSON file. If the job is not archived, nothing is done.
+func UpdateComments(job *schema.Job, comments []*schema.JobComment) error {
+	if job.State == schema.JobStateRunning || !useArchive {
+		return nil
+	}
+
+	jobMeta, err := ar.LoadJobMeta(job)
+	if err != nil {
+		log.Warn("Error while loading job metadata from archiveBackend")
+		return err
+	}
+
+	jobMeta.Comments = comments
+	return ar.StoreJobMeta(jobMeta)
+}
@@ -29,11 +29,15 @@ import (
 
 type FsArchiveConfig struct {
 	Path string `json:"path"`
+	// Timesteps (in seconds) of downsampled levels stored alongside the
+	// full resolution metric data of every imported job. Example: [600,300]
+	Resolutions []int `json:"resolutions"`
 }
 
 type FsArchive struct {
-	path     string
-	clusters []string
+	path        string
+	clusters    []string
+	resolutions []int
 }
 
 type clusterInfo struct {
@@ -128,6 +132,7 @@ func (fsa *FsArchive) Init(rawConfig json.RawMessage) (uint64, error) {
 		return 0, err
 	}
 	fsa.path = config.Path
+	fsa.resolutions = sortedResolutions(config.Resolutions)
 
 	b, err := os.ReadFile(filepath.Join(fsa.path, "version.txt"))
 	if err != nil {
@@ -377,7 +382,11 @@ func (fsa *FsArchive) CompressLast(starttime int64) int64 {
 	return last
 }
 
-func (fsa *FsArchive) LoadJobData(job *schema.Job) (schema.JobData, error) {
+func (fsa *FsArchive) LoadJobData(job *schema.Job, resolution int) (schema.JobData, error) {
+	if level := fsa.selectLevel(job, resolution); level != 0 {
+		return loadJobData(getPath(job, fsa.path, levelFilename(level)), false)
+	}
+
 	var isCompressed bool = true
 	filename := getPath(job, fsa.path, "data.json.gz")
 
@@ -570,6 +579,8 @@ func (fsa *FsArchive) ImportJob(
 	}
 	if err := f.Close(); err != nil {
 		log.Warn("Error while closing data.json file")
+		return err
 	}
-	return err
+
+	return fsa.storeLevels(dir, jobData)
 }
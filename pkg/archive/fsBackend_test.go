@@ -119,7 +119,7 @@ func TestLoadJobData(t *testing.T) {
 	jobIn.JobID = 1403244
 	jobIn.Cluster = "emmy"
 
-	data, err := fsa.LoadJobData(&jobIn)
+	data, err := fsa.LoadJobData(&jobIn, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -152,7 +152,7 @@ func BenchmarkLoadJobData(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		fsa.LoadJobData(&jobIn)
+		fsa.LoadJobData(&jobIn, 0)
 	}
 }
 
@@ -172,7 +172,7 @@ func BenchmarkLoadJobDataCompressed(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		fsa.LoadJobData(&jobIn)
+		fsa.LoadJobData(&jobIn, 0)
 	}
 }
 
@@ -208,3 +208,57 @@ func TestIter(t *testing.T) {
 		}
 	}
 }
+
+func TestImportJobLevels(t *testing.T) {
+	tmpdir := t.TempDir()
+	jobarchive := filepath.Join(tmpdir, "job-archive")
+	util.CopyDir("./testdata/archive/", jobarchive)
+	archiveCfg := fmt.Sprintf("{\"path\": \"%s\", \"resolutions\": [300, 600]}", jobarchive)
+
+	var fsa FsArchive
+	if _, err := fsa.Init(json.RawMessage(archiveCfg)); err != nil {
+		t.Fatal(err)
+	}
+
+	jobIn := schema.Job{BaseJob: schema.JobDefaults}
+	jobIn.StartTime = time.Unix(1608923076, 0)
+	jobIn.JobID = 1403244
+	jobIn.Cluster = "emmy"
+
+	jobMeta, err := fsa.LoadJobMeta(&jobIn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobData, err := fsa.LoadJobData(&jobIn, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fsa.ImportJob(jobMeta, &jobData); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, level := range []int{300, 600} {
+		if !util.CheckFileExists(getPath(&jobIn, jobarchive, levelFilename(level))) {
+			t.Fatalf("level %d not stored", level)
+		}
+	}
+
+	tests := map[int]int{0: 60, 120: 60, 300: 300, 900: 300, 1200: 600}
+	for resolution, timestep := range tests {
+		data, err := fsa.LoadJobData(&jobIn, resolution)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		jm := data["flops_any"][schema.MetricScopeNode]
+		if jm.Timestep != timestep {
+			t.Errorf("resolution %d: expected timestep %d, got %d", resolution, timestep, jm.Timestep)
+		}
+		if timestep != 60 && jm.OriginalTimestep != 60 {
+			t.Errorf("resolution %d: expected original timestep 60, got %d", resolution, jm.OriginalTimestep)
+		}
+		if jm.Series[0].Statistics != jobData["flops_any"][schema.MetricScopeNode].Series[0].Statistics {
+			t.Errorf("resolution %d: series statistics differ from full resolution", resolution)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package archive
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ClusterCockpit/cc-backend/internal/util"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/resampler"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// Downsampled levels are stored next to the full resolution `data.json` as
+// `data-<timestep>.json`, one file per configured resolution.
+func levelFilename(timestep int) string {
+	return fmt.Sprintf("data-%d.json", timestep)
+}
+
+// Returns the configured resolutions in descending order (coarsest first),
+// dropping invalid and duplicate entries.
+func sortedResolutions(resolutions []int) []int {
+	res := make([]int, 0, len(resolutions))
+	for _, r := range resolutions {
+		if r > 0 && !util.Contains(res, r) {
+			res = append(res, r)
+		}
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(res)))
+	return res
+}
+
+// Returns the timestep of the coarsest stored level that still satisfies
+// the requested resolution, or 0 if the full resolution data has to be used.
+// A level satisfies a resolution if it is not coarser than the resolution and
+// can be resampled to it (the resolution is a multiple of the level).
+func (fsa *FsArchive) selectLevel(job *schema.Job, resolution int) int {
+	if resolution <= 0 {
+		return 0
+	}
+
+	for _, level := range fsa.resolutions {
+		if level > resolution || resolution%level != 0 {
+			continue
+		}
+
+		if util.CheckFileExists(getPath(job, fsa.path, levelFilename(level))) {
+			return level
+		}
+	}
+
+	return 0
+}
+
+// Writes one downsampled copy of jobData per configured resolution into dir.
+// Levels which would not be smaller than the full resolution data (e.g. short
+// jobs or metrics with a coarser native timestep) are not written.
+func (fsa *FsArchive) storeLevels(dir string, jobData *schema.JobData) error {
+	for _, level := range fsa.resolutions {
+		data, resampled := ResampleJobData(jobData, level)
+		if !resampled {
+			continue
+		}
+
+		f, err := os.Create(filepath.Join(dir, levelFilename(level)))
+		if err != nil {
+			log.Errorf("Error while creating filepath for %s", levelFilename(level))
+			return err
+		}
+		if err := EncodeJobData(f, &data); err != nil {
+			f.Close()
+			log.Errorf("Error while encoding job metricdata to %s file", levelFilename(level))
+			return err
+		}
+		if err := f.Close(); err != nil {
+			log.Warnf("Error while closing %s file", levelFilename(level))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns a copy of jobData with all series downsampled to timestep. The
+// per-series statistics are kept from the full resolution data and the native
+// timestep is recorded in OriginalTimestep. Statistics series are dropped and
+// recomputed on load from the downsampled series. Metrics which cannot be
+// resampled are shared with jobData. The second return value reports if at
+// least one metric was resampled.
+func ResampleJobData(jobData *schema.JobData, timestep int) (schema.JobData, bool) {
+	res := make(schema.JobData, len(*jobData))
+	resampled := false
+
+	for metric, scopes := range *jobData {
+		res[metric] = make(map[schema.MetricScope]*schema.JobMetric, len(scopes))
+		for scope, jm := range scopes {
+			level := &schema.JobMetric{
+				Unit:   jm.Unit,
+				Series: make([]schema.Series, 0, len(jm.Series)),
+			}
+
+			newTimestep := 0
+			for _, series := range jm.Series {
+				data, ts, err := resampler.LargestTriangleThreeBucket(series.Data, jm.Timestep, timestep)
+				if err != nil || (newTimestep != 0 && ts != newTimestep) {
+					// Native timestep does not divide the level or series differ in length
+					newTimestep = jm.Timestep
+					break
+				}
+
+				series.Data = data
+				newTimestep = ts
+				level.Series = append(level.Series, series)
+			}
+
+			if newTimestep == 0 || newTimestep == jm.Timestep {
+				// Keep full resolution for this metric
+				res[metric][scope] = jm
+				continue
+			}
+
+			level.Timestep = newTimestep
+			level.OriginalTimestep = jm.Timestep
+			if jm.OriginalTimestep != 0 {
+				level.OriginalTimestep = jm.OriginalTimestep
+			}
+			resampled = true
+			res[metric][scope] = level
+		}
+	}
+
+	return res, resampled
+}
@@ -0,0 +1,43 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package schema
+
+import "time"
+
+// Allocation struct
+// Budget of a project on a cluster for an allocation period. A budget that
+// is nil is not limited.
+type Allocation struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	CoreHours *float64  `json:"coreHours,omitempty" db:"core_hours"`
+	AccHours  *float64  `json:"accHours,omitempty" db:"acc_hours"`
+	Energy    *float64  `json:"energy,omitempty" db:"energy"` // kWh
+	Project   string    `json:"project" db:"project"`
+	Cluster   string    `json:"cluster" db:"cluster"`
+	ID        int64     `json:"id" db:"id"`
+}
+
+// AllocationUsage struct
+// Consumption of the jobs of a project started within an allocation period
+type AllocationUsage struct {
+	RemainingCoreHours *float64 `json:"remainingCoreHours,omitempty"`
+	RemainingAccHours  *float64 `json:"remainingAccHours,omitempty"`
+	RemainingEnergy    *float64 `json:"remainingEnergy,omitempty"`
+	CoreHours          float64  `json:"coreHours"`
+	AccHours           float64  `json:"accHours"`
+	Energy             float64  `json:"energy"`
+	CoreHoursPerDay    float64  `json:"coreHoursPerDay"` // Burn rate over the elapsed part of the period
+	AccHoursPerDay     float64  `json:"accHoursPerDay"`
+	EnergyPerDay       float64  `json:"energyPerDay"`
+	NumJobs            int      `json:"numJobs"`
+}
+
+// Over reports if any budget of the allocation is used up by the usage.
+func (a *Allocation) Over(coreHours, accHours, energy float64) bool {
+	return (a.CoreHours != nil && coreHours > *a.CoreHours) ||
+		(a.AccHours != nil && accHours > *a.AccHours) ||
+		(a.Energy != nil && energy > *a.Energy)
+}
@@ -0,0 +1,59 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package schema
+
+import "slices"
+
+// All API tokens start with this prefix to tell them apart from JWTs.
+const ApiTokenPrefix = "cct_"
+
+// ApiToken model
+// @Description A named API token of a user. The token itself is only stored as hash.
+type ApiToken struct {
+	ID        int64           `json:"id" db:"id"`
+	Name      string          `json:"name" db:"name" example:"slurm-adapter"`
+	Username  string          `json:"username" db:"username" example:"abcd100h"`
+	Scopes    []ApiTokenScope `json:"scopes" example:"job_control"`
+	Clusters  []string        `json:"clusters" example:"fritz"`                                 // Clusters the token is restricted to, empty for all clusters
+	CreatedAt int64           `json:"createdAt" db:"created_at" example:"1649723812"`           // Unix timestamp
+	ExpiresAt int64           `json:"expiresAt,omitempty" db:"expires_at" example:"1681259812"` // Unix timestamp, 0 if the token does not expire
+	LastUsed  int64           `json:"lastUsed,omitempty" db:"last_used" example:"1649723812"`   // Unix timestamp
+	Token     string          `json:"token,omitempty" example:"cct_5f0c..."`                    // Only returned on creation
+}
+
+// ApiTokenScope restricts the requests an API token can be used for. The
+// roles of the user still apply.
+type ApiTokenScope string
+
+const (
+	ApiTokenScopeRead       ApiTokenScope = "read"        // Requests that do not change anything
+	ApiTokenScopeJobControl ApiTokenScope = "job_control" // Start, stop and update jobs
+	ApiTokenScopeJobEdit    ApiTokenScope = "job_edit"    // Tag, edit, import, delete and restore jobs
+	ApiTokenScopeNodeState  ApiTokenScope = "node_state"  // Update node and machine states
+	ApiTokenScopeAll        ApiTokenScope = "all"         // Everything the user is allowed to do
+)
+
+func (s ApiTokenScope) Valid() bool {
+	return s == ApiTokenScopeRead ||
+		s == ApiTokenScopeJobControl ||
+		s == ApiTokenScopeJobEdit ||
+		s == ApiTokenScopeNodeState ||
+		s == ApiTokenScopeAll
+}
+
+// HasScope is true if the token has the scope or the scope 'all'.
+func (t *ApiToken) HasScope(scope ApiTokenScope) bool {
+	return slices.Contains(t.Scopes, ApiTokenScopeAll) || slices.Contains(t.Scopes, scope)
+}
+
+// AllowsCluster is true if the token is not restricted to other clusters.
+func (t *ApiToken) AllowsCluster(cluster string) bool {
+	return len(t.Clusters) == 0 || slices.Contains(t.Clusters, cluster)
+}
+
+// Expired is true if the token has an expiry date before `now`.
+func (t *ApiToken) Expired(now int64) bool {
+	return t.ExpiresAt != 0 && t.ExpiresAt <= now
+}
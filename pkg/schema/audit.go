@@ -0,0 +1,19 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package schema
+
+// AuditEntry model
+// @Description An entry of the audit log of administrative and API actions.
+type AuditEntry struct {
+	ID         int64  `json:"id" db:"id"`
+	TimeStamp  int64  `json:"timeStamp" db:"time_stamp" example:"1649723812"` // Unix timestamp
+	Username   string `json:"username" db:"username" example:"abcd100h"`
+	SourceIP   string `json:"sourceIp" db:"source_ip" example:"10.0.0.1"`
+	AuthMethod string `json:"authMethod" db:"auth_method" example:"session:ldap"`
+	Action     string `json:"action" db:"action" example:"DELETE /api/jobs/delete_job/{id}"`
+	Target     string `json:"target" db:"target" example:"/api/jobs/delete_job/123"`
+	Payload    string `json:"payload" db:"payload"`             // Summary of the request payload, secrets are redacted
+	Status     int    `json:"status" db:"status" example:"200"` // HTTP status of the response
+}
@@ -23,6 +23,7 @@ type BaseJob struct {
 	User               string             `json:"user" db:"hpc_user" example:"abcd100h"`
 	State              JobState           `json:"jobState" db:"job_state" example:"completed" enums:"completed,failed,cancelled,stopped,timeout,out_of_memory"`
 	Tags               []*Tag             `json:"tags,omitempty"`
+	Comments           []*JobComment      `json:"comments,omitempty"`
 	RawEnergyFootprint []byte             `json:"-" db:"energy_footprint"`
 	RawFootprint       []byte             `json:"-" db:"footprint"`
 	RawMetaData        []byte             `json:"-" db:"meta_data"`
@@ -35,6 +36,8 @@ type BaseJob struct {
 	Energy             float64            `json:"energy" db:"energy"`
 	ArrayJobId         int64              `json:"arrayJobId,omitempty" db:"array_job_id" example:"123000"`
 	Walltime           int64              `json:"walltime,omitempty" db:"walltime" example:"86400" minimum:"1"`
+	SubmitTime         int64              `json:"submitTime,omitempty" db:"submit_time" example:"1649720000" minimum:"0"`     // Zero if unknown
+	EligibleTime       int64              `json:"eligibleTime,omitempty" db:"eligible_time" example:"1649720000" minimum:"0"` // Zero if unknown
 	JobID              int64              `json:"jobId" db:"job_id" example:"123000"`
 	Duration           int32              `json:"duration" db:"duration" example:"43200" minimum:"1"`
 	SMT                int32              `json:"smt,omitempty" db:"smt" example:"4"`
@@ -123,6 +126,21 @@ type Tag struct {
 	ID    int64  `json:"id" db:"id"`
 }
 
+// JobComment model
+// @Description A comment on a job. If a time range is set, the comment annotates the metric plots of the job.
+type JobComment struct {
+	ParentID  *int64 `json:"parentId,omitempty" db:"parent_id"` // Comment this comment is a reply to
+	From      *int64 `json:"from,omitempty" db:"range_from" example:"1649723812"`
+	To        *int64 `json:"to,omitempty" db:"range_to" example:"1649727412"`
+	Author    string `json:"author" db:"author" example:"abcd100h"`
+	Scope     string `json:"scope" db:"comment_scope" example:"global"`
+	Text      string `json:"text" db:"comment_text" example:"This job hit a Lustre issue"`
+	Metric    string `json:"metric,omitempty" db:"metric" example:"mem_bw"` // Annotated metric, all metrics if empty
+	CreatedAt int64  `json:"createdAt" db:"created_at" example:"1649730000"`
+	ID        int64  `json:"id" db:"id"`
+	JobID     int64  `json:"-" db:"job_id"`
+}
+
 // Resource model
 // @Description A resource used by a job
 type Resource struct {
@@ -132,6 +150,33 @@ type Resource struct {
 	Accelerators  []string `json:"accelerators,omitempty"`
 }
 
+// JobEvent model
+// @Description An entry of the lifecycle of a job.
+type JobEvent struct {
+	Event     JobEventType `json:"event" db:"event" example:"stop"`
+	State     *JobState    `json:"jobState,omitempty" db:"job_state" example:"completed"` // Job state after the event, if it changed the job state
+	Actor     string       `json:"actor,omitempty" db:"actor" example:"abcd100h"`         // Empty if triggered by cc-backend itself
+	Details   string       `json:"details,omitempty" db:"details" example:"Debug:Testjob"`
+	TimeStamp int64        `json:"timeStamp" db:"time_stamp" example:"1649723812"`
+	ID        int64        `json:"id" db:"id"`
+	JobID     int64        `json:"-" db:"job_id"`
+}
+
+type JobEventType string
+
+const (
+	JobEventStart           JobEventType = "start"
+	JobEventStop            JobEventType = "stop"
+	JobEventArchived        JobEventType = "archived"
+	JobEventArchivingFailed JobEventType = "archiving_failed"
+	JobEventMetadata        JobEventType = "metadata"
+	JobEventTag             JobEventType = "tag"
+	JobEventUntag           JobEventType = "untag"
+	JobEventDelete          JobEventType = "delete"
+	JobEventRestore         JobEventType = "restore"
+	JobEventUpdate          JobEventType = "update"
+)
+
 type JobState string
 
 const (
@@ -21,6 +21,8 @@ type JobMetric struct {
 	Unit             Unit         `json:"unit"`
 	Series           []Series     `json:"series"`
 	Timestep         int          `json:"timestep"`
+	// Native timestep if the data was downsampled for archiving
+	OriginalTimestep int `json:"originalTimestep,omitempty"`
 }
 
 type Series struct {
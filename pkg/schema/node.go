@@ -0,0 +1,114 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Node struct
+// Scheduler and health state of a node as stored in the node table
+type Node struct {
+	ID            int64                  `json:"id" db:"id"`
+	Hostname      string                 `json:"hostname" db:"hostname" example:"f0101"`
+	Cluster       string                 `json:"cluster" db:"cluster" example:"fritz"`
+	SubCluster    string                 `json:"subCluster" db:"subcluster" example:"main"`
+	NodeState     NodeState              `json:"nodeState" db:"node_state" example:"idle"`
+	HealthState   HealthState            `json:"healthState" db:"health_state" example:"full"`
+	Reason        string                 `json:"reason,omitempty" db:"reason" example:"Not responding"`
+	StateSince    int64                  `json:"stateSince" db:"state_since" example:"1649723812"` // Unix timestamp of the last state change
+	TimeStamp     int64                  `json:"timeStamp" db:"time_stamp" example:"1649724812"`   // Unix timestamp of the last update
+	RawHealthInfo []byte                 `json:"-" db:"health_info"`
+	HealthInfo    map[string]interface{} `json:"healthInfo,omitempty"`
+	RawInventory  []byte                 `json:"-" db:"inventory"`
+	Inventory     map[string]interface{} `json:"inventory,omitempty"`
+}
+
+// NodeStateChange struct
+// Entry of the state history of a node
+type NodeStateChange struct {
+	NodeState   NodeState   `json:"nodeState" db:"node_state"`
+	HealthState HealthState `json:"healthState" db:"health_state"`
+	Reason      string      `json:"reason,omitempty" db:"reason"`
+	TimeStamp   int64       `json:"timeStamp" db:"time_stamp"`
+}
+
+type NodeState string
+
+const (
+	NodeStateAllocated   NodeState = "allocated"
+	NodeStateReserved    NodeState = "reserved"
+	NodeStateIdle        NodeState = "idle"
+	NodeStateMixed       NodeState = "mixed"
+	NodeStateDown        NodeState = "down"
+	NodeStateDrain       NodeState = "drain"
+	NodeStateMaintenance NodeState = "maintenance"
+	NodeStateUnknown     NodeState = "unknown"
+)
+
+func (e *NodeState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("SCHEMA/NODE > enums must be strings")
+	}
+
+	*e = NodeState(str)
+	if !e.Valid() {
+		return errors.New("SCHEMA/NODE > invalid node state")
+	}
+
+	return nil
+}
+
+func (e NodeState) MarshalGQL(w io.Writer) {
+	fmt.Fprintf(w, "\"%s\"", e)
+}
+
+func (e NodeState) Valid() bool {
+	return e == NodeStateAllocated ||
+		e == NodeStateReserved ||
+		e == NodeStateIdle ||
+		e == NodeStateMixed ||
+		e == NodeStateDown ||
+		e == NodeStateDrain ||
+		e == NodeStateMaintenance ||
+		e == NodeStateUnknown
+}
+
+type HealthState string
+
+const (
+	HealthStateFull    HealthState = "full"
+	HealthStatePartial HealthState = "partial"
+	HealthStateFailed  HealthState = "failed"
+	HealthStateUnknown HealthState = "unknown"
+)
+
+func (e *HealthState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("SCHEMA/NODE > enums must be strings")
+	}
+
+	*e = HealthState(str)
+	if !e.Valid() {
+		return errors.New("SCHEMA/NODE > invalid health state")
+	}
+
+	return nil
+}
+
+func (e HealthState) MarshalGQL(w io.Writer) {
+	fmt.Fprintf(w, "\"%s\"", e)
+}
+
+func (e HealthState) Valid() bool {
+	return e == HealthStateFull ||
+		e == HealthStatePartial ||
+		e == HealthStateFailed ||
+		e == HealthStateUnknown
+}
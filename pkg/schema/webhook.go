@@ -0,0 +1,63 @@
+// Copyright (C) NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package schema
+
+// Webhook model
+// @Description An endpoint that is notified about job lifecycle and node state events.
+type Webhook struct {
+	ID        int64          `json:"id" db:"id"`
+	Name      string         `json:"name" db:"name" example:"chat"`
+	URL       string         `json:"url" db:"url" example:"https://chat.example.com/hooks/cc"`
+	Secret    string         `json:"secret,omitempty" db:"secret"` // Key of the HMAC-SHA256 payload signature, only returned on creation
+	Events    []WebhookEvent `json:"events" example:"job_started,job_stopped"`
+	RawEvents string         `json:"-" db:"events"`
+	Active    bool           `json:"active" db:"active"`
+	CreatedBy string         `json:"createdBy" db:"created_by" example:"admin"`
+	CreatedAt int64          `json:"createdAt" db:"created_at" example:"1649723812"` // Unix timestamp
+}
+
+type WebhookEvent string
+
+const (
+	WebhookEventJobStarted         WebhookEvent = "job_started"
+	WebhookEventJobStopped         WebhookEvent = "job_stopped"
+	WebhookEventJobArchived        WebhookEvent = "job_archived"
+	WebhookEventJobArchivingFailed WebhookEvent = "job_archiving_failed"
+	WebhookEventJobTagged          WebhookEvent = "job_tagged"
+	WebhookEventNodeStateChanged   WebhookEvent = "node_state_changed"
+)
+
+func (e WebhookEvent) Valid() bool {
+	return e == WebhookEventJobStarted ||
+		e == WebhookEventJobStopped ||
+		e == WebhookEventJobArchived ||
+		e == WebhookEventJobArchivingFailed ||
+		e == WebhookEventJobTagged ||
+		e == WebhookEventNodeStateChanged
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery model
+// @Description A request to a webhook, pending until it is delivered or all attempts failed.
+type WebhookDelivery struct {
+	ID             int64                 `json:"id" db:"id"`
+	WebhookID      int64                 `json:"webhookId" db:"webhook_id"`
+	Event          WebhookEvent          `json:"event" db:"event" example:"job_started"`
+	Payload        string                `json:"payload" db:"payload"`
+	Status         WebhookDeliveryStatus `json:"status" db:"status" example:"delivered"`
+	Attempts       int                   `json:"attempts" db:"attempts" example:"1"`
+	NextAttempt    int64                 `json:"nextAttempt" db:"next_attempt" example:"1649723812"`           // Unix timestamp
+	ResponseStatus int                   `json:"responseStatus" db:"response_status" example:"200"`            // HTTP status of the last attempt, 0 if there was no response
+	Error          string                `json:"error,omitempty" db:"error"`                                   // Error of the last attempt
+	CreatedAt      int64                 `json:"createdAt" db:"created_at" example:"1649723812"`               // Unix timestamp
+	DeliveredAt    int64                 `json:"deliveredAt,omitempty" db:"delivered_at" example:"1649723812"` // Unix timestamp
+}